@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// defaultDEX serves streams that leave Contract.dex empty.
+const defaultDEX = "uniswapv3"
+
+// DEXAdapter is everything a stream needs to know about one DEX protocol. The
+// rest of the server only deals with tokens, pool states and decoded events.
+type DEXAdapter interface {
+	// Tokens returns the tokens the pool trades, in the pool's own order.
+	Tokens(opts *bind.CallOpts) ([]common.Address, error)
+	// State reads the pool state at opts.BlockNumber.
+	State(opts *bind.CallOpts) (*poolState, error)
+	// PriceEvents selects the logs after which the pool price may have changed.
+	PriceEvents() ethereum.FilterQuery
+	// SwapEvents selects the logs of trades against the pool.
+	SwapEvents() ethereum.FilterQuery
+	// Decode turns a log selected by either query into an event.
+	Decode(l types.Log) (*poolEvent, error)
+	// Price returns the marginal price of token base in token quote, both
+	// indices into Tokens, in raw token units.
+	Price(state *poolState, base int, quote int) *big.Rat
+}
+
+// deployer is implemented by adapters whose pools can be traced back to the
+// factory that deployed them.
+type deployer interface {
+	// Deployment returns nil if the pool is of a kind no factory is known for.
+	Deployment(opts *bind.CallOpts) (*deployment, error)
+}
+
+// deployment is the factory a pool names and the address that factory deploys
+// pools with the pool's parameters to.
+type deployment struct {
+	dex     string // dex the pool was detected as, key of its factory in chainInfo.factories
+	factory common.Address
+	address common.Address
+}
+
+// dexAliases maps dex names to the dex their pools are detected as.
+var dexAliases = map[string]string{
+	"quickswapv3": "algebra",
+}
+
+// quoter is implemented by adapters that can ask the pool what a trade of
+// amount of token base returns in token quote, fees included.
+type quoter interface {
+	Quote(opts *bind.CallOpts, base int, quote int, amount *big.Int) (*big.Int, error)
+}
+
+// batchReader is implemented by adapters that can queue the reads of State in
+// a multicall. The returned function assembles the state once the batch ran.
+type batchReader interface {
+	QueueState(batch *multicall) func() (*poolState, error)
+}
+
+// batchQuoter is the batched form of quoter.
+type batchQuoter interface {
+	QueueQuote(batch *multicall, base int, quote int, amount *big.Int) func() (*big.Int, error)
+}
+
+// poolState is a snapshot of a pool. Adapters fill in what their protocol has.
+type poolState struct {
+	sqrtPriceX96 *big.Int
+	tick         *big.Int
+	liquidity    *big.Int
+	reserves     []*big.Int // raw token balances, in token order
+	// amplification is the A parameter of StableSwap pools.
+	amplification *big.Int
+	// weights are the normalized weights of weighted pools, 18 decimals.
+	weights []*big.Int
+	// scalingFactors bring raw balances to 18 decimals, including token rates.
+	scalingFactors []*big.Int
+	// quote is what one whole base token fetches in raw quote tokens, fees
+	// included, for adapters that can quote trades.
+	quote *big.Int
+}
+
+// poolEvent is a decoded log of a pool.
+type poolEvent struct {
+	log   types.Log
+	state *poolState // state after the event, nil if the event does not carry it
+	swap  *swapEvent // nil unless the event is a trade
+}
+
+type swapEvent struct {
+	sender    common.Address
+	recipient common.Address
+	amounts   []*big.Int // per token, positive when the pool received it
+}
+
+// adapterFactory binds an adapter to the pool at address.
+type adapterFactory func(opts *bind.CallOpts, address common.Address, backend bind.ContractBackend) (DEXAdapter, error)
+
+var adapters = map[string]adapterFactory{
+	"uniswapv3":     newUniswapV3Adapter,
+	"pancakeswapv3": newUniswapV3Adapter,
+	"algebra":       newUniswapV3Adapter,
+	"quickswapv3":   newUniswapV3Adapter,
+	"uniswapv2":     newUniswapV2Adapter,
+	"sushiswap":     newUniswapV2Adapter,
+	"pancakeswapv2": newUniswapV2Adapter,
+	"curve":         newCurveAdapter,
+	"balancer":      newBalancerAdapter,
+	"balancerv2":    newBalancerAdapter,
+}
+
+// batchAdapterFactory is adapterFactory for adapters that can queue the reads
+// binding their pool takes in a multicall. The returned function binds the
+// adapter once the batch ran.
+type batchAdapterFactory func(batch *multicall, address common.Address, backend bind.ContractBackend) func() (DEXAdapter, error)
+
+var batchAdapters = map[string]batchAdapterFactory{
+	"uniswapv3":     queueUniswapV3Adapter,
+	"pancakeswapv3": queueUniswapV3Adapter,
+	"algebra":       queueUniswapV3Adapter,
+	"quickswapv3":   queueUniswapV3Adapter,
+}
+
+// lookupAdapter returns the canonical name and factory of the adapter for dex.
+func lookupAdapter(dex string) (string, adapterFactory, error) {
+	name := strings.ToLower(dex)
+	if name == "" {
+		name = defaultDEX
+	}
+	factory, ok := adapters[name]
+	if !ok {
+		var supported []string
+		for name := range adapters {
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+		return "", nil, fmt.Errorf("dex %q is not supported, use one of %v", dex, strings.Join(supported, ", "))
+	}
+	return name, factory, nil
+}
+
+// mergeQueries selects the logs of two queries of a pool, which differ only in
+// the events they select.
+func mergeQueries(a ethereum.FilterQuery, b ethereum.FilterQuery) ethereum.FilterQuery {
+	merged := a
+	merged.Topics = append([][]common.Hash{}, a.Topics...)
+	merged.Topics[0] = append([]common.Hash{}, a.Topics[0]...)
+	for _, topic := range b.Topics[0] {
+		if !containsHash(merged.Topics[0], topic) {
+			merged.Topics[0] = append(merged.Topics[0], topic)
+		}
+	}
+	return merged
+}
+
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// eventTopic returns the topic identifying the event name of a generated binding.
+func eventTopic(metadata *bind.MetaData, name string) common.Hash {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Events[name].ID
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxLogRange is the widest block range requested with a single eth_getLogs call.
+const maxLogRange = 5000
+
+// rateLimitAttempts is how often a rate limited log query is tried before the
+// stream gives up.
+const rateLimitAttempts = 8
+
+// endOfBlock is the cursor log index of updates that reflect the state after a whole block.
+const endOfBlock = math.MaxUint32
+
+// logPosition orders logs within the chain.
+type logPosition struct {
+	block uint64
+	index uint
+}
+
+func positionOf(l types.Log) logPosition {
+	return logPosition{block: l.BlockNumber, index: l.Index}
+}
+
+func (a logPosition) before(b logPosition) bool {
+	return a.block < b.block || a.block == b.block && a.index < b.index
+}
+
+func cursorOf(position logPosition) *proto.Cursor {
+	return &proto.Cursor{Blocknumber: position.block, LogIndex: uint32(position.index)}
+}
+
+// startPosition returns where a stream has to replay from, if the client asked
+// for history either by cursor or by fromBlock.
+func startPosition(contract *proto.Contract) (logPosition, bool) {
+	if cursor := contract.Cursor; cursor != nil {
+		if cursor.LogIndex == endOfBlock {
+			return logPosition{block: cursor.Blocknumber + 1}, true
+		}
+		return logPosition{block: cursor.Blocknumber, index: uint(cursor.LogIndex) + 1}, true
+	}
+	if contract.FromBlock != 0 {
+		return logPosition{block: contract.FromBlock}, true
+	}
+	return logPosition{}, false
+}
+
+// eventFollower delivers logs in chain order and exactly once, whether
+// they come from historical log queries or from a live subscription. Events
+// from blocks that get reorganised away are retracted and delivered again from
+// the canonical chain.
+type eventFollower struct {
+	reader    *contractReader
+	query     ethereum.FilterQuery
+	next      logPosition // first position not delivered yet
+	window    blockWindow
+	retracted common.Hash
+	emit      func(types.Log) error
+	retract   func(*proto.Retraction) error
+}
+
+func (f *eventFollower) deliver(l types.Log) error {
+	position := positionOf(l)
+	if position.before(f.next) {
+		return nil
+	}
+	if err := f.emit(l); err != nil {
+		return err
+	}
+	f.next = logPosition{block: position.block, index: position.index + 1}
+	f.window.add(l.BlockNumber, l.BlockHash, true)
+	return nil
+}
+
+// rewind makes the follower deliver everything from block on again.
+func (f *eventFollower) rewind(block uint64) {
+	if start := (logPosition{block: block}); start.before(f.next) {
+		f.next = start
+	}
+}
+
+// removed handles a log a live subscription withdrew because of a reorg.
+func (f *eventFollower) removed(l types.Log) error {
+	f.rewind(l.BlockNumber)
+	if l.BlockHash == f.retracted {
+		return nil
+	}
+	f.retracted = l.BlockHash
+	return f.retract(removedLogRetraction(l))
+}
+
+// reconcile retracts what was delivered from blocks that left the canonical
+// chain ending in head and rewinds to the fork point.
+func (f *eventFollower) reconcile(ctx context.Context, head *blockHeader) error {
+	orphaned, err := f.window.orphaned(ctx, f.reader.backend, head)
+	if err != nil || len(orphaned) == 0 {
+		return err
+	}
+	f.rewind(orphaned[0].number)
+	if retraction := retractionOf(orphaned); retraction != nil {
+		log.Printf("Reorg below block %v, retracting events of %v blocks", retraction.Blocknumber, len(retraction.OrphanedBlockHashes))
+		return f.retract(retraction)
+	}
+	return nil
+}
+
+// backfill pages through the logs of [start, end]. The page size is halved
+// whenever the provider rejects a query as too large and grows back afterwards.
+func (f *eventFollower) backfill(ctx context.Context, start uint64, end uint64) error {
+	span := uint64(maxLogRange)
+	throttled := 0
+	for start <= end {
+		stop := start + span - 1
+		if stop > end {
+			stop = end
+		}
+		query := f.query
+		query.FromBlock, query.ToBlock = new(big.Int).SetUint64(start), new(big.Int).SetUint64(stop)
+		logs, err := f.reader.backend.FilterLogs(ctx, query)
+		if err != nil {
+			if span > 1 && isRangeTooLarge(err) {
+				span /= 2
+				log.Printf("Narrowing log queries to %v blocks - %v", span, err)
+				continue
+			}
+			if isRateLimited(err) && throttled+1 < rateLimitAttempts {
+				// Smaller pages would only cost more requests; wait instead.
+				backoff := minBackoff << throttled
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+				throttled++
+				log.Printf("Log queries are rate limited, retrying in %v - %v", backoff, err)
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(backoff):
+				}
+				continue
+			}
+			return fmt.Errorf("pool logs could not be fetched - %w", err)
+		}
+		throttled = 0
+		for _, l := range logs {
+			if err := f.deliver(l); err != nil {
+				return err
+			}
+		}
+
+		if completed := (logPosition{block: stop + 1}); f.next.before(completed) {
+			f.next = completed
+		}
+		start = stop + 1
+		if span < maxLogRange {
+			span *= 2
+		}
+	}
+	return nil
+}
+
+// isRangeTooLarge recognises the ways providers refuse oversized eth_getLogs
+// queries, such as Infura's "query returned more than 10000 results" or
+// Alchemy's "Log response size exceeded". Rate limits are not among them.
+func isRangeTooLarge(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, hint := range []string{"too many results", "returned more than", "block range", "response size"} {
+		if strings.Contains(message, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRateLimited recognises providers throttling requests.
+func isRateLimited(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	for _, hint := range []string{"rate limit", "rate exceeded", "too many requests"} {
+		if strings.Contains(message, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// followEvents replays the logs selected by query from position `from`
+// on and then keeps following the chain. On websocket endpoints the live subscription is
+// opened before the backfill starts, so swaps mined in the meantime are neither
+// lost nor emitted twice. Bounded ranges (contract.ToBlock) are always polled and
+// end once the last block has been delivered.
+func (r *contractReader) followEvents(ctx context.Context, contract *proto.Contract, query ethereum.FilterQuery, from logPosition,
+	emit func(types.Log) error, retract func(*proto.Retraction) error) error {
+	follower := &eventFollower{reader: r, query: query, next: from, emit: emit, retract: retract}
+	to := contract.ToBlock
+
+	live := make(chan types.Log, 1024)
+	var subscription ethereum.Subscription
+	var subscriptionErr <-chan error
+	if to == 0 {
+		var err error
+		subscription, err = r.subscribe(ctx, contract, query, live)
+		if err != nil {
+			return err
+		}
+		if subscription != nil {
+			defer func() { subscription.Unsubscribe() }()
+			subscriptionErr = subscription.Err()
+		}
+	}
+
+	header, err := r.head(ctx, contract)
+	if err != nil {
+		return err
+	}
+	head := header.number
+	if to != 0 && to < head {
+		head = to
+	}
+	if err := follower.backfill(ctx, from.block, head); err != nil {
+		return err
+	}
+	if to != 0 && follower.next.block > to {
+		return nil
+	}
+
+	var ticks <-chan time.Time
+	if subscriptionErr == nil {
+		ticker := time.NewTicker(r.scrapeInterval(contract))
+		defer ticker.Stop()
+		ticks = ticker.C
+		log.Printf("Polling logs of %v every %v", r.address, r.scrapeInterval(contract))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-subscriptionErr:
+			// Resubscribe, possibly through another provider, and backfill
+			// whatever was mined while no subscription was open.
+			log.Printf("Log subscription dropped, resubscribing - %v", err)
+			subscription, err = r.resubscribe(ctx, contract, query, live, subscription)
+			if err != nil {
+				return err
+			}
+			subscriptionErr = subscription.Err()
+			header, err := r.head(ctx, contract)
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "%v", err)
+			}
+			if err := follower.backfill(ctx, follower.next.block, header.number); err != nil {
+				return status.Errorf(codes.Unavailable, "%v", err)
+			}
+		case l := <-live:
+			if l.Removed {
+				if err := follower.removed(l); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := follower.deliver(l); err != nil {
+				return err
+			}
+		case <-ticks:
+			header, err := r.head(ctx, contract)
+			if err != nil {
+				log.Printf("%v", err)
+				continue
+			}
+			if err := follower.reconcile(ctx, header); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				log.Printf("Reorg check failed - %v", err)
+				continue
+			}
+			head := header.number
+			if to != 0 && to < head {
+				head = to
+			}
+			if head < follower.next.block {
+				continue
+			}
+			if err := follower.backfill(ctx, follower.next.block, head); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				log.Printf("%v", err)
+				continue
+			}
+			if head == header.number {
+				follower.window.add(head, header.hash, false)
+			}
+			if to != 0 && follower.next.block > to {
+				return nil
+			}
+		}
+	}
+}
+
+// streamHistory serves StreamContract requests with a fromBlock or cursor. Their position
+// in the chain is specific to the client, so they bypass the shared hub and emit
+// one update per price event.
+func (server *DEXStreamerServerImp) streamHistory(contract *proto.Contract, stream proto.DEXStreamer_StreamContractServer) error {
+	p, err := server.dialPool(stream.Context(), contract)
+	if err != nil {
+		return err
+	}
+	from, _ := startPosition(contract)
+	ctx := stream.Context()
+	return streamStatus(p.followEvents(ctx, contract, p.adapter.PriceEvents(), from, func(l types.Log) error {
+		event, err := p.decode(l)
+		if err != nil {
+			return err
+		}
+		response, err := p.eventResponse(ctx, event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(response)
+	}, func(retraction *proto.Retraction) error {
+		return stream.Send(p.retractionResponse(retraction))
+	}))
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"errors"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLogQueryErrors(t *testing.T) {
+	tests := []struct {
+		err         error
+		tooLarge    bool
+		rateLimited bool
+	}{
+		{errors.New("query returned more than 10000 results"), true, false},
+		{errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), true, false},
+		{errors.New("exceed maximum block range: 5000"), true, false},
+		{errors.New("too many results in block range"), true, false},
+		{errors.New("project ID request rate exceeded"), false, true},
+		{errors.New("daily request count exceeded, request rate limited"), false, true},
+		{rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}, false, true},
+		{errors.New("execution reverted"), false, false},
+		{errors.New("gas limit exceeded"), false, false},
+	}
+	for _, test := range tests {
+		if got := isRangeTooLarge(test.err); got != test.tooLarge {
+			t.Errorf("isRangeTooLarge(%q) = %v, want %v", test.err, got, test.tooLarge)
+		}
+		if got := isRateLimited(test.err); got != test.rateLimited {
+			t.Errorf("isRateLimited(%q) = %v, want %v", test.err, got, test.rateLimited)
+		}
+	}
+}
+
+func TestFollowEventsResubscribeFailure(t *testing.T) {
+	node := &testNode{head: 100, subscriptions: 1}
+	first, second := serve(t, node, "first"), serve(t, node, "second")
+	chain := &chainInfo{name: "test", blockTime: time.Hour}
+	r := &contractReader{backend: &providerPool{chain: chain, providers: []*provider{first, second}, preferred: first}, chain: chain}
+
+	emitted := make(chan struct{}, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- r.followEvents(context.Background(), &proto.Contract{}, ethereum.FilterQuery{}, logPosition{block: 100},
+			func(types.Log) error { emitted <- struct{}{}; return nil }, func(*proto.Retraction) error { return nil })
+	}()
+	receive(t, emitted)
+	// Dropping the first provider's connection ends the subscription, and the
+	// second provider refuses a new one.
+	first.rpcClient.Close()
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.Unavailable {
+			t.Fatalf("followEvents ended with %v, want Unavailable", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("followEvents did not end")
+	}
+}
+
+func TestStartPosition(t *testing.T) {
+	tests := []struct {
+		name     string
+		contract *proto.Contract
+		want     logPosition
+		replay   bool
+	}{
+		{"live", &proto.Contract{}, logPosition{}, false},
+		{"from block", &proto.Contract{FromBlock: 100}, logPosition{block: 100}, true},
+		{"after a log", &proto.Contract{Cursor: &proto.Cursor{Blocknumber: 100, LogIndex: 4}}, logPosition{block: 100, index: 5}, true},
+		{"after a block", &proto.Contract{Cursor: &proto.Cursor{Blocknumber: 100, LogIndex: endOfBlock}}, logPosition{block: 101}, true},
+		{"cursor before from block", &proto.Contract{FromBlock: 50, Cursor: &proto.Cursor{Blocknumber: 100}}, logPosition{block: 100, index: 1}, true},
+	}
+	for _, test := range tests {
+		got, replay := startPosition(test.contract)
+		if got != test.want || replay != test.replay {
+			t.Errorf("%v: startPosition = %v, %v, want %v, %v", test.name, got, replay, test.want, test.replay)
+		}
+	}
+}
+
+func testLog(block uint64, index uint) types.Log {
+	return types.Log{BlockNumber: block, Index: index, BlockHash: common.BigToHash(new(big.Int).SetUint64(block)), Topics: []common.Hash{}}
+}
+
+// testFollower follows node from next, recording what it emits and retracts.
+func testFollower(t *testing.T, node *testNode, next logPosition) (*eventFollower, *[]logPosition, *[]*proto.Retraction) {
+	chain := &chainInfo{name: "test"}
+	reader := &contractReader{backend: &providerPool{chain: chain, providers: []*provider{serve(t, node, "test")}}, chain: chain}
+	emitted, retracted := new([]logPosition), new([]*proto.Retraction)
+	return &eventFollower{reader: reader, next: next,
+		emit: func(l types.Log) error {
+			*emitted = append(*emitted, positionOf(l))
+			return nil
+		},
+		retract: func(retraction *proto.Retraction) error {
+			*retracted = append(*retracted, retraction)
+			return nil
+		},
+	}, emitted, retracted
+}
+
+func TestEventFollowerDeliver(t *testing.T) {
+	tests := []struct {
+		name    string
+		next    logPosition
+		logs    []types.Log
+		emitted []logPosition
+	}{
+		{"in order", logPosition{block: 5}, []types.Log{testLog(5, 0), testLog(5, 1), testLog(6, 0)},
+			[]logPosition{{5, 0}, {5, 1}, {6, 0}}},
+		{"already delivered", logPosition{block: 5, index: 2}, []types.Log{testLog(4, 7), testLog(5, 1), testLog(5, 2), testLog(6, 0)},
+			[]logPosition{{5, 2}, {6, 0}}},
+		{"twice", logPosition{block: 5}, []types.Log{testLog(5, 0), testLog(5, 0), testLog(5, 1), testLog(5, 1)},
+			[]logPosition{{5, 0}, {5, 1}}},
+	}
+	for _, test := range tests {
+		f, emitted, _ := testFollower(t, &testNode{}, test.next)
+		for _, l := range test.logs {
+			if err := f.deliver(l); err != nil {
+				t.Fatalf("%v: %v", test.name, err)
+			}
+		}
+		if !reflect.DeepEqual(*emitted, test.emitted) {
+			t.Errorf("%v: emitted %v, want %v", test.name, *emitted, test.emitted)
+		}
+		last := test.emitted[len(test.emitted)-1]
+		if want := (logPosition{block: last.block, index: last.index + 1}); f.next != want {
+			t.Errorf("%v: next is %v, want %v", test.name, f.next, want)
+		}
+	}
+}
+
+func TestEventFollowerRemoved(t *testing.T) {
+	f, emitted, retracted := testFollower(t, &testNode{}, logPosition{block: 5})
+	for _, l := range []types.Log{testLog(5, 0), testLog(5, 1), testLog(6, 0)} {
+		if err := f.deliver(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A reorg withdraws both logs of block 6 and 5, newest first.
+	for _, l := range []types.Log{testLog(6, 0), testLog(5, 1), testLog(5, 0)} {
+		l.Removed = true
+		if err := f.removed(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if f.next != (logPosition{block: 5}) {
+		t.Fatalf("next is %v after the reorg, want block 5", f.next)
+	}
+	if len(*retracted) != 2 || (*retracted)[0].Blocknumber != 6 || (*retracted)[1].Blocknumber != 5 {
+		t.Fatalf("retracted %v, want blocks 6 and 5 once each", *retracted)
+	}
+	// The canonical logs of block 5 are delivered again.
+	if err := f.deliver(testLog(5, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if want := []logPosition{{5, 0}, {5, 1}, {6, 0}, {5, 0}}; !reflect.DeepEqual(*emitted, want) {
+		t.Fatalf("emitted %v, want %v", *emitted, want)
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	logs := []types.Log{testLog(999, 0), testLog(2500, 0), testLog(2500, 1), testLog(7000, 3)}
+	tests := []struct {
+		name     string
+		maxRange uint64
+		next     logPosition
+		end      uint64
+		queries  [][2]uint64
+		emitted  []logPosition
+	}{
+		{"pages", 0, logPosition{}, 9999, [][2]uint64{{0, 4999}, {5000, 9999}},
+			[]logPosition{{999, 0}, {2500, 0}, {2500, 1}, {7000, 3}}},
+		{"narrowed and widened again", 3000, logPosition{}, 9999,
+			[][2]uint64{{0, 4999}, {0, 2499}, {2500, 7499}, {2500, 4999}, {5000, 9999}, {5000, 7499}, {7500, 9999}},
+			[]logPosition{{999, 0}, {2500, 0}, {2500, 1}, {7000, 3}}},
+		{"resumed within a block", 0, logPosition{block: 2500, index: 1}, 3000, [][2]uint64{{2500, 3000}},
+			[]logPosition{{2500, 1}}},
+		{"nothing left", 0, logPosition{block: 3001}, 3000, nil, nil},
+	}
+	for _, test := range tests {
+		node := &testNode{logs: logs, maxRange: test.maxRange}
+		f, emitted, _ := testFollower(t, node, test.next)
+		if err := f.backfill(context.Background(), test.next.block, test.end); err != nil {
+			t.Fatalf("%v: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(node.queries, test.queries) {
+			t.Errorf("%v: queried %v, want %v", test.name, node.queries, test.queries)
+		}
+		if !reflect.DeepEqual(*emitted, test.emitted) {
+			t.Errorf("%v: emitted %v, want %v", test.name, *emitted, test.emitted)
+		}
+		if want := (logPosition{block: test.end + 1}); test.next.before(want) && f.next != want {
+			t.Errorf("%v: next is %v, want %v", test.name, f.next, want)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	balancerPool "github.com/toamto94/dex-streamer.git/pkg/abigen/balancerPool"
+	balancerVault "github.com/toamto94/dex-streamer.git/pkg/abigen/balancerVault"
+	"math/big"
+)
+
+var (
+	balancerSwapTopic           = eventTopic(balancerVault.BalancerVaultAbigenMetaData, "Swap")
+	balancerBalanceChangedTopic = eventTopic(balancerVault.BalancerVaultAbigenMetaData, "PoolBalanceChanged")
+	balancerOne                 = pow10(18)
+)
+
+// balancerAdapter reads Balancer V2 weighted and stable pools. Their balances
+// live in the Vault, which also emits the Swap events of every pool, so state
+// and events are looked up by the pool's poolId rather than its address.
+type balancerAdapter struct {
+	address       common.Address
+	poolId        common.Hash
+	vault         common.Address
+	poolInstance  *balancerPool.BalancerPoolAbigen
+	vaultInstance *balancerVault.BalancerVaultAbigen
+	stable        bool
+	tokens        []common.Address
+	positions     []int // position of each token in the Vault's token list
+}
+
+func newBalancerAdapter(opts *bind.CallOpts, address common.Address, backend bind.ContractBackend) (DEXAdapter, error) {
+	poolInstance, err := balancerPool.NewBalancerPoolAbigen(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	poolId, err := poolInstance.GetPoolId(opts)
+	if err != nil {
+		return nil, fmt.Errorf("poolId could not be fetched - %w", err)
+	}
+	vault, err := poolInstance.GetVault(opts)
+	if err != nil {
+		return nil, fmt.Errorf("vault could not be fetched - %w", err)
+	}
+	vaultInstance, err := balancerVault.NewBalancerVaultAbigen(vault, backend)
+	if err != nil {
+		return nil, err
+	}
+	a := &balancerAdapter{address: address, poolId: poolId, vault: vault, poolInstance: poolInstance, vaultInstance: vaultInstance}
+
+	if _, err := poolInstance.GetNormalizedWeights(opts); err != nil {
+		if !reverted(err) {
+			return nil, err
+		}
+		if _, err := poolInstance.GetAmplificationParameter(opts); err != nil {
+			return nil, fmt.Errorf("pool is neither weighted nor stable - %w", err)
+		}
+		a.stable = true
+		if _, err := poolInstance.GetScalingFactors(opts); err != nil {
+			return nil, fmt.Errorf("scaling factors could not be fetched - %w", err)
+		}
+	}
+
+	// Composable pools list their own pool token in the Vault; it is not traded.
+	poolTokens, err := vaultInstance.GetPoolTokens(opts, poolId)
+	if err != nil {
+		return nil, fmt.Errorf("pool tokens could not be fetched - %w", err)
+	}
+	for i, token := range poolTokens.Tokens {
+		if token != address {
+			a.tokens = append(a.tokens, token)
+			a.positions = append(a.positions, i)
+		}
+	}
+	return a, nil
+}
+
+func (a *balancerAdapter) Tokens(opts *bind.CallOpts) ([]common.Address, error) {
+	return a.tokens, nil
+}
+
+// pick keeps the entries of a Vault ordered list that belong to traded tokens.
+func (a *balancerAdapter) pick(values []*big.Int) ([]*big.Int, error) {
+	picked := make([]*big.Int, len(a.positions))
+	for i, position := range a.positions {
+		if position >= len(values) {
+			return nil, fmt.Errorf("pool reports %v values for %v tokens", len(values), len(a.positions))
+		}
+		picked[i] = values[position]
+	}
+	return picked, nil
+}
+
+func (a *balancerAdapter) State(opts *bind.CallOpts) (*poolState, error) {
+	poolTokens, err := a.vaultInstance.GetPoolTokens(opts, a.poolId)
+	if err != nil {
+		return nil, fmt.Errorf("pool tokens could not be fetched - %w", err)
+	}
+	state := &poolState{}
+	if state.reserves, err = a.pick(poolTokens.Balances); err != nil {
+		return nil, err
+	}
+	if !a.stable {
+		// Weights are read every time since liquidity bootstrapping pools change them.
+		weights, err := a.poolInstance.GetNormalizedWeights(opts)
+		if err != nil {
+			return nil, fmt.Errorf("weights could not be fetched - %w", err)
+		}
+		if len(weights) != len(state.reserves) {
+			return nil, fmt.Errorf("pool reports %v weights for %v tokens", len(weights), len(state.reserves))
+		}
+		state.weights = weights
+		return state, nil
+	}
+
+	amplification, err := a.poolInstance.GetAmplificationParameter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("amplification could not be fetched - %w", err)
+	}
+	state.amplification = new(big.Int).Quo(amplification.Value, amplification.Precision)
+	scalingFactors, err := a.poolInstance.GetScalingFactors(opts)
+	if err != nil {
+		return nil, fmt.Errorf("scaling factors could not be fetched - %w", err)
+	}
+	if state.scalingFactors, err = a.pick(scalingFactors); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (a *balancerAdapter) QueueState(batch *multicall) func() (*poolState, error) {
+	poolTokens := batch.add(a.vault, balancerVault.BalancerVaultAbigenMetaData, "getPoolTokens", [32]byte(a.poolId))
+	var weights, amplification, scalingFactors *batchedCall
+	if a.stable {
+		amplification = batch.add(a.address, balancerPool.BalancerPoolAbigenMetaData, "getAmplificationParameter")
+		scalingFactors = batch.add(a.address, balancerPool.BalancerPoolAbigenMetaData, "getScalingFactors")
+	} else {
+		weights = batch.add(a.address, balancerPool.BalancerPoolAbigenMetaData, "getNormalizedWeights")
+	}
+	return func() (*poolState, error) {
+		if poolTokens.err != nil {
+			return nil, fmt.Errorf("pool tokens could not be fetched - %w", poolTokens.err)
+		}
+		state := &poolState{}
+		var err error
+		if state.reserves, err = a.pick(*abi.ConvertType(poolTokens.out[1], new([]*big.Int)).(*[]*big.Int)); err != nil {
+			return nil, err
+		}
+		if !a.stable {
+			if weights.err != nil {
+				return nil, fmt.Errorf("weights could not be fetched - %w", weights.err)
+			}
+			state.weights = *abi.ConvertType(weights.out[0], new([]*big.Int)).(*[]*big.Int)
+			if len(state.weights) != len(state.reserves) {
+				return nil, fmt.Errorf("pool reports %v weights for %v tokens", len(state.weights), len(state.reserves))
+			}
+			return state, nil
+		}
+		if amplification.err != nil {
+			return nil, fmt.Errorf("amplification could not be fetched - %w", amplification.err)
+		}
+		value := *abi.ConvertType(amplification.out[0], new(*big.Int)).(**big.Int)
+		precision := *abi.ConvertType(amplification.out[2], new(*big.Int)).(**big.Int)
+		state.amplification = new(big.Int).Quo(value, precision)
+		if scalingFactors.err != nil {
+			return nil, fmt.Errorf("scaling factors could not be fetched - %w", scalingFactors.err)
+		}
+		if state.scalingFactors, err = a.pick(*abi.ConvertType(scalingFactors.out[0], new([]*big.Int)).(*[]*big.Int)); err != nil {
+			return nil, err
+		}
+		return state, nil
+	}
+}
+
+func (a *balancerAdapter) PriceEvents() ethereum.FilterQuery {
+	return ethereum.FilterQuery{Addresses: []common.Address{a.vault},
+		Topics: [][]common.Hash{{balancerSwapTopic, balancerBalanceChangedTopic}, {a.poolId}}}
+}
+
+func (a *balancerAdapter) SwapEvents() ethereum.FilterQuery {
+	return ethereum.FilterQuery{Addresses: []common.Address{a.vault},
+		Topics: [][]common.Hash{{balancerSwapTopic}, {a.poolId}}}
+}
+
+// Decode decodes Vault swaps of the pool. The Vault does not log who traded,
+// so sender and recipient stay empty. Joins and exits only tell that the
+// balances changed.
+func (a *balancerAdapter) Decode(l types.Log) (*poolEvent, error) {
+	if len(l.Topics) < 2 {
+		return nil, errors.New("log without poolId")
+	}
+	if l.Topics[1] != a.poolId {
+		return nil, fmt.Errorf("log of pool %v", l.Topics[1])
+	}
+	switch l.Topics[0] {
+	case balancerBalanceChangedTopic:
+		return &poolEvent{log: l}, nil
+	case balancerSwapTopic:
+		swap, err := a.vaultInstance.ParseSwap(l)
+		if err != nil {
+			return nil, err
+		}
+		amounts := make([]*big.Int, len(a.tokens))
+		for i, token := range a.tokens {
+			amounts[i] = new(big.Int)
+			switch token {
+			case swap.TokenIn:
+				amounts[i].Set(swap.AmountIn)
+			case swap.TokenOut:
+				amounts[i].Neg(swap.AmountOut)
+			}
+		}
+		return &poolEvent{log: l, swap: &swapEvent{amounts: amounts}}, nil
+	}
+	return nil, fmt.Errorf("unexpected event %v", l.Topics[0])
+}
+
+// Price is the spot price before fees. Weighted pools price token base at
+// (B_quote / w_quote) / (B_base / w_base), where decimal scaling cancels out.
+// Stable pools use the StableSwap invariant on balances scaled to 18 decimals
+// and token rates.
+func (a *balancerAdapter) Price(state *poolState, base int, quote int) *big.Rat {
+	for _, balance := range state.reserves {
+		if balance.Sign() == 0 {
+			return new(big.Rat)
+		}
+	}
+	if !a.stable {
+		price := new(big.Rat).SetFrac(state.reserves[quote], state.weights[quote])
+		return price.Quo(price, new(big.Rat).SetFrac(state.reserves[base], state.weights[base]))
+	}
+	xp := make([]*big.Int, len(state.reserves))
+	for i, balance := range state.reserves {
+		xp[i] = new(big.Int).Mul(balance, state.scalingFactors[i])
+		if xp[i].Quo(xp[i], balancerOne).Sign() == 0 {
+			return new(big.Rat)
+		}
+	}
+	price := stableSwapPrice(xp, state.amplification, base, quote)
+	return price.Mul(price, new(big.Rat).SetFrac(state.scalingFactors[base], state.scalingFactors[quote]))
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+// raw returns amount·10^exponent.
+func raw(amount int64, exponent int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), pow10(exponent))
+}
+
+func TestBalancerWeightedPrice(t *testing.T) {
+	tests := []struct {
+		name          string
+		reserves      []*big.Int
+		weights       []*big.Int
+		base, quote   int
+		baseDecimals  uint8
+		quoteDecimals uint8
+		want          *big.Rat
+	}{
+		// (B_q/w_q)/(B_b/w_b) = (10000/0.2)/(1000000/0.8).
+		{"80/20 BAL in WETH", []*big.Int{raw(1000000, 18), raw(10000, 18)}, []*big.Int{raw(8, 17), raw(2, 17)}, 0, 1, 18, 18, rat("0.04")},
+		{"80/20 WETH in BAL", []*big.Int{raw(1000000, 18), raw(10000, 18)}, []*big.Int{raw(8, 17), raw(2, 17)}, 1, 0, 18, 18, rat("25")},
+		{"50/50 WETH in USDC", []*big.Int{raw(2000000, 6), raw(1000, 18)}, []*big.Int{raw(5, 17), raw(5, 17)}, 1, 0, 18, 6, rat("2000")},
+		{"50/25/25", []*big.Int{raw(100, 18), raw(50, 18), raw(25, 18)}, []*big.Int{raw(5, 17), raw(25, 16), raw(25, 16)}, 0, 2, 18, 18, rat("0.5")},
+		{"empty", []*big.Int{raw(100, 18), new(big.Int)}, []*big.Int{raw(5, 17), raw(5, 17)}, 0, 1, 18, 18, rat("0")},
+	}
+	a := &balancerAdapter{}
+	for _, test := range tests {
+		got := scalePrice(a.Price(&poolState{reserves: test.reserves, weights: test.weights}, test.base, test.quote),
+			test.baseDecimals, test.quoteDecimals)
+		if got.Cmp(test.want) != 0 {
+			t.Errorf("%v: price %v, want %v", test.name, got.FloatString(18), test.want.FloatString(18))
+		}
+	}
+}
+
+func TestBalancerStablePrice(t *testing.T) {
+	tests := []struct {
+		name           string
+		reserves       []*big.Int
+		scalingFactors []*big.Int
+		base, quote    int
+		baseDecimals   uint8
+		quoteDecimals  uint8
+		xp             []*big.Int // the balances in 18 decimals the price must match, nil for want
+		want           *big.Rat
+	}{
+		{"USDC in DAI balanced", []*big.Int{raw(1000000, 6), raw(1000000, 18)}, []*big.Int{raw(1, 30), raw(1, 18)}, 0, 1, 6, 18, nil, rat("1")},
+		{"DAI in USDC balanced", []*big.Int{raw(1000000, 6), raw(1000000, 18)}, []*big.Int{raw(1, 30), raw(1, 18)}, 1, 0, 18, 6, nil, rat("1")},
+		// The rate provider values a wstETH at 1.15 WETH.
+		{"wstETH in WETH at its rate", []*big.Int{raw(1000, 18), raw(1150, 18)}, []*big.Int{raw(115, 16), raw(1, 18)}, 0, 1, 18, 18, nil, rat("1.15")},
+		{"USDC in USDT imbalanced", []*big.Int{raw(1000000, 6), raw(3000000, 6)}, []*big.Int{raw(1, 30), raw(1, 30)}, 0, 1, 6, 6,
+			units(1000000, 3000000), nil},
+		{"USDC in DAI imbalanced", []*big.Int{raw(1000000, 6), raw(3000000, 18)}, []*big.Int{raw(1, 30), raw(1, 18)}, 0, 1, 6, 18,
+			units(1000000, 3000000), nil},
+		{"DAI in USDC imbalanced", []*big.Int{raw(1000000, 6), raw(3000000, 18)}, []*big.Int{raw(1, 30), raw(1, 18)}, 1, 0, 18, 6,
+			units(1000000, 3000000), nil},
+		{"empty", []*big.Int{raw(1000000, 6), new(big.Int)}, []*big.Int{raw(1, 30), raw(1, 18)}, 0, 1, 6, 18, nil, rat("0")},
+	}
+	a := &balancerAdapter{stable: true}
+	amplification := big.NewInt(200)
+	for _, test := range tests {
+		state := &poolState{reserves: test.reserves, scalingFactors: test.scalingFactors, amplification: amplification}
+		got := scalePrice(a.Price(state, test.base, test.quote), test.baseDecimals, test.quoteDecimals)
+		want := test.want
+		if test.xp != nil {
+			// Scaling to 18 decimals and back must leave the invariant's price as is.
+			want = stableSwapPrice(test.xp, amplification, test.base, test.quote)
+		}
+		if got.Cmp(want) != 0 {
+			t.Errorf("%v: price %v, want %v", test.name, got.FloatString(18), want.FloatString(18))
+		}
+	}
+}
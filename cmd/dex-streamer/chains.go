@@ -0,0 +1,138 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"strings"
+	"time"
+)
+
+// chainInfo describes a chain the streamer can serve.
+type chainInfo struct {
+	name          string
+	id            uint64
+	blockTime     time.Duration
+	confirmations uint32                    // default depth for streams that name no block tag
+	factories     map[string]common.Address // canonical factory per lowercase dex name
+	tokens        map[string]common.Address // well known tokens per upper case symbol
+}
+
+var chains = map[string]*chainInfo{
+	"ethereum": {
+		name:          "ethereum",
+		id:            1,
+		blockTime:     12 * time.Second,
+		confirmations: 2,
+		factories: map[string]common.Address{
+			"uniswapv3":     common.HexToAddress("0x1F98431c8aD98523631AE4a59f267346ea31F984"),
+			"pancakeswapv3": common.HexToAddress("0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865"),
+		},
+		tokens: map[string]common.Address{
+			"WETH": common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"),
+			"USDC": common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+			"USDT": common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+			"DAI":  common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+			"WBTC": common.HexToAddress("0x2260FAC5E5542a773Aa44fBCfeDf7C193bc2C599"),
+		},
+	},
+	"polygon": {
+		name:          "polygon",
+		id:            137,
+		blockTime:     2 * time.Second,
+		confirmations: 32,
+		factories: map[string]common.Address{
+			"uniswapv3": common.HexToAddress("0x1F98431c8aD98523631AE4a59f267346ea31F984"),
+		},
+		tokens: map[string]common.Address{
+			"WMATIC": common.HexToAddress("0x0d500B1d8E8eF31E21C99d1Db9A6444d3ADf1270"),
+			"WETH":   common.HexToAddress("0x7ceB23fD6bC0adD59E62ac25578270cFf1b9f619"),
+			"USDC":   common.HexToAddress("0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359"),
+			"USDC.E": common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"),
+			"USDT":   common.HexToAddress("0xc2132D05D31c914a87C6611C10748AEb04B58e8F"),
+			"DAI":    common.HexToAddress("0x8f3Cf7ad23Cd3CaDbD9735AFf958023239c6A063"),
+			"WBTC":   common.HexToAddress("0x1BFD67037B42Cf73acF2047067bd4F2C47D9BfD6"),
+		},
+	},
+	"arbitrum": {
+		name:      "arbitrum",
+		id:        42161,
+		blockTime: 250 * time.Millisecond,
+		factories: map[string]common.Address{
+			"uniswapv3":     common.HexToAddress("0x1F98431c8aD98523631AE4a59f267346ea31F984"),
+			"pancakeswapv3": common.HexToAddress("0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865"),
+		},
+		tokens: map[string]common.Address{
+			"WETH":   common.HexToAddress("0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"),
+			"USDC":   common.HexToAddress("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+			"USDC.E": common.HexToAddress("0xFF970A61A04b1cA14834A43f5dE4533eBDDB5CC8"),
+			"USDT":   common.HexToAddress("0xFd086bC7CD5C481DCC9C85ebE478A1C0b69FCbb9"),
+			"DAI":    common.HexToAddress("0xDA10009cBd5D07dd0CeCc66161FC93D7c9000da1"),
+			"WBTC":   common.HexToAddress("0x2f2a2543B76A4166549F7aaB2e75Bef0aefC5B0f"),
+		},
+	},
+	"optimism": {
+		name:      "optimism",
+		id:        10,
+		blockTime: 2 * time.Second,
+		factories: map[string]common.Address{
+			"uniswapv3": common.HexToAddress("0x1F98431c8aD98523631AE4a59f267346ea31F984"),
+		},
+		tokens: map[string]common.Address{
+			"WETH": common.HexToAddress("0x4200000000000000000000000000000000000006"),
+			"USDC": common.HexToAddress("0x0b2C639c533813f4Aa9D7837cAf62653d097Ff85"),
+			"USDT": common.HexToAddress("0x94b008aA00579c1307B0EF2c499aD98a8ce58e58"),
+			"DAI":  common.HexToAddress("0xDA10009cBd5D07dd0CeCc66161FC93D7c9000da1"),
+			"WBTC": common.HexToAddress("0x68f180fcCe6836688e9084f035309E29Bf0A2095"),
+		},
+	},
+	"base": {
+		name:      "base",
+		id:        8453,
+		blockTime: 2 * time.Second,
+		factories: map[string]common.Address{
+			"uniswapv3":     common.HexToAddress("0x33128a8fC17869897dcE68Ed026d694621f6FDfD"),
+			"pancakeswapv3": common.HexToAddress("0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865"),
+		},
+		tokens: map[string]common.Address{
+			"WETH": common.HexToAddress("0x4200000000000000000000000000000000000006"),
+			"USDC": common.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"),
+			"DAI":  common.HexToAddress("0x50c5725949A6F0c72E6C4a641F24049A917DB0Cb"),
+		},
+	},
+	"bsc": {
+		name:          "bsc",
+		id:            56,
+		blockTime:     3 * time.Second,
+		confirmations: 3,
+		factories: map[string]common.Address{
+			"uniswapv3":     common.HexToAddress("0xdB1d10011AD0Ff90774D0C6Bb92e5C5c8b4461F7"),
+			"pancakeswapv3": common.HexToAddress("0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865"),
+		},
+		tokens: map[string]common.Address{
+			"WBNB": common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c"),
+			"USDT": common.HexToAddress("0x55d398326f99059fF775485246999027B3197955"),
+			"USDC": common.HexToAddress("0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d"),
+			"BTCB": common.HexToAddress("0x7130d2A12B9BCbFAe4f2634d864A1Ee1Ce3Ead9c"),
+			"ETH":  common.HexToAddress("0x2170Ed0880ac9A755fd29B2688956BD959F933F8"),
+		},
+	},
+}
+
+func lookupChain(name string) (*chainInfo, bool) {
+	chain, ok := chains[strings.ToLower(name)]
+	return chain, ok
+}
+
+// factory returns the canonical factory of dex on the chain.
+func (c *chainInfo) factory(dex string) (common.Address, bool) {
+	address, ok := c.factories[strings.ToLower(dex)]
+	return address, ok
+}
+
+// token resolves a token address or well known symbol.
+func (c *chainInfo) token(reference string) (common.Address, bool) {
+	if common.IsHexAddress(reference) {
+		return common.HexToAddress(reference), true
+	}
+	address, ok := c.tokens[strings.ToUpper(reference)]
+	return address, ok
+}
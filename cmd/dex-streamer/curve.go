@@ -0,0 +1,277 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	curveStableSwap "github.com/toamto94/dex-streamer.git/pkg/abigen/curveStableSwap"
+	erc20Standard "github.com/toamto94/dex-streamer.git/pkg/abigen/erc20Standard"
+	"math/big"
+)
+
+// maxCurveCoins bounds the probing for the coins of a pool.
+const maxCurveCoins = 8
+
+var curveTokenExchangeTopic = eventTopic(curveStableSwap.CurveStableSwapAbigenMetaData, "TokenExchange")
+
+// curveAdapter reads Curve StableSwap pools with any number of coins. Older
+// pools index coins and balances by int128, newer ones by uint256; which one a
+// pool uses is probed when binding it.
+type curveAdapter struct {
+	address         common.Address
+	poolInstance    *curveStableSwap.CurveStableSwapAbigen
+	int128Indices   bool
+	coins           []common.Address
+	rates           []*big.Int // bring the balances of each coin to a common precision
+	liquidityTopics []common.Hash
+}
+
+func newCurveAdapter(opts *bind.CallOpts, address common.Address, backend bind.ContractBackend) (DEXAdapter, error) {
+	poolInstance, err := curveStableSwap.NewCurveStableSwapAbigen(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	a := &curveAdapter{address: address, poolInstance: poolInstance}
+	if _, err := poolInstance.Coins(opts, big.NewInt(0)); err != nil {
+		if !reverted(err) {
+			return nil, err
+		}
+		a.int128Indices = true
+	}
+	for i := int64(0); i < maxCurveCoins; i++ {
+		coin, err := a.coin(opts, i)
+		if err != nil {
+			if reverted(err) && i >= 2 {
+				break
+			}
+			return nil, fmt.Errorf("coin %v could not be fetched - %w", i, err)
+		}
+		a.coins = append(a.coins, coin)
+	}
+
+	decimals := make([]uint8, len(a.coins))
+	widest := uint8(18)
+	for i, coin := range a.coins {
+		tokenInstance, err := erc20Standard.NewErc20StandardAbigen(coin, backend)
+		if err != nil {
+			return nil, err
+		}
+		if decimals[i], err = tokenInstance.Decimals(opts); err != nil {
+			return nil, fmt.Errorf("decimals of coin %v could not be fetched - %w", i, err)
+		}
+		if decimals[i] > widest {
+			widest = decimals[i]
+		}
+	}
+	for _, d := range decimals {
+		a.rates = append(a.rates, pow10(int64(widest-d)))
+	}
+
+	// Liquidity events carry fixed size arrays, so their topics depend on the number of coins.
+	n := len(a.coins)
+	for _, signature := range []string{
+		fmt.Sprintf("AddLiquidity(address,uint256[%v],uint256[%v],uint256,uint256)", n, n),
+		fmt.Sprintf("RemoveLiquidity(address,uint256[%v],uint256[%v],uint256)", n, n),
+		fmt.Sprintf("RemoveLiquidityImbalance(address,uint256[%v],uint256[%v],uint256,uint256)", n, n),
+		"RemoveLiquidityOne(address,uint256,uint256)",
+		"RemoveLiquidityOne(address,int128,uint256,uint256,uint256)",
+	} {
+		a.liquidityTopics = append(a.liquidityTopics, crypto.Keccak256Hash([]byte(signature)))
+	}
+	return a, nil
+}
+
+func (a *curveAdapter) coin(opts *bind.CallOpts, i int64) (common.Address, error) {
+	if a.int128Indices {
+		return a.poolInstance.Coins0(opts, big.NewInt(i))
+	}
+	return a.poolInstance.Coins(opts, big.NewInt(i))
+}
+
+func (a *curveAdapter) balance(opts *bind.CallOpts, i int) (*big.Int, error) {
+	if a.int128Indices {
+		return a.poolInstance.Balances0(opts, big.NewInt(int64(i)))
+	}
+	return a.poolInstance.Balances(opts, big.NewInt(int64(i)))
+}
+
+func (a *curveAdapter) Tokens(opts *bind.CallOpts) ([]common.Address, error) {
+	return a.coins, nil
+}
+
+func (a *curveAdapter) State(opts *bind.CallOpts) (*poolState, error) {
+	state := &poolState{}
+	for i := range a.coins {
+		balance, err := a.balance(opts, i)
+		if err != nil {
+			return nil, fmt.Errorf("balance %v could not be fetched - %w", i, err)
+		}
+		state.reserves = append(state.reserves, balance)
+	}
+	amplification, err := a.poolInstance.A(opts)
+	if err != nil {
+		return nil, fmt.Errorf("A could not be fetched - %w", err)
+	}
+	state.amplification = amplification
+	return state, nil
+}
+
+func (a *curveAdapter) QueueState(batch *multicall) func() (*poolState, error) {
+	method := "balances"
+	if a.int128Indices {
+		method = "balances0"
+	}
+	balances := make([]*batchedCall, len(a.coins))
+	for i := range a.coins {
+		balances[i] = batch.add(a.address, curveStableSwap.CurveStableSwapAbigenMetaData, method, big.NewInt(int64(i)))
+	}
+	amplification := batch.add(a.address, curveStableSwap.CurveStableSwapAbigenMetaData, "A")
+	return func() (*poolState, error) {
+		state := &poolState{}
+		for i, balance := range balances {
+			if balance.err != nil {
+				return nil, fmt.Errorf("balance %v could not be fetched - %w", i, balance.err)
+			}
+			state.reserves = append(state.reserves, *abi.ConvertType(balance.out[0], new(*big.Int)).(**big.Int))
+		}
+		if amplification.err != nil {
+			return nil, fmt.Errorf("A could not be fetched - %w", amplification.err)
+		}
+		state.amplification = *abi.ConvertType(amplification.out[0], new(*big.Int)).(**big.Int)
+		return state, nil
+	}
+}
+
+// Quote asks the pool how much of coin quote a trade of amount of coin base
+// returns, fees included.
+func (a *curveAdapter) Quote(opts *bind.CallOpts, base int, quote int, amount *big.Int) (*big.Int, error) {
+	return a.poolInstance.GetDy(opts, big.NewInt(int64(base)), big.NewInt(int64(quote)), amount)
+}
+
+func (a *curveAdapter) QueueQuote(batch *multicall, base int, quote int, amount *big.Int) func() (*big.Int, error) {
+	dy := batch.add(a.address, curveStableSwap.CurveStableSwapAbigenMetaData, "get_dy", big.NewInt(int64(base)), big.NewInt(int64(quote)), amount)
+	return func() (*big.Int, error) {
+		if dy.err != nil {
+			return nil, dy.err
+		}
+		return *abi.ConvertType(dy.out[0], new(*big.Int)).(**big.Int), nil
+	}
+}
+
+func (a *curveAdapter) PriceEvents() ethereum.FilterQuery {
+	topics := append([]common.Hash{curveTokenExchangeTopic}, a.liquidityTopics...)
+	return ethereum.FilterQuery{Addresses: []common.Address{a.address}, Topics: [][]common.Hash{topics}}
+}
+
+func (a *curveAdapter) SwapEvents() ethereum.FilterQuery {
+	return ethereum.FilterQuery{Addresses: []common.Address{a.address}, Topics: [][]common.Hash{{curveTokenExchangeTopic}}}
+}
+
+// Decode decodes trades. Liquidity events only tell that the balances changed
+// and carry neither state nor trade.
+func (a *curveAdapter) Decode(l types.Log) (*poolEvent, error) {
+	if len(l.Topics) == 0 {
+		return nil, errors.New("anonymous log")
+	}
+	if l.Topics[0] != curveTokenExchangeTopic {
+		for _, topic := range a.liquidityTopics {
+			if l.Topics[0] == topic {
+				return &poolEvent{log: l}, nil
+			}
+		}
+		return nil, fmt.Errorf("unexpected event %v", l.Topics[0])
+	}
+	exchange, err := a.poolInstance.ParseTokenExchange(l)
+	if err != nil {
+		return nil, err
+	}
+	sold, bought := exchange.SoldId.Int64(), exchange.BoughtId.Int64()
+	if sold < 0 || sold >= int64(len(a.coins)) || bought < 0 || bought >= int64(len(a.coins)) {
+		return nil, fmt.Errorf("exchange between unknown coins %v and %v", sold, bought)
+	}
+	amounts := make([]*big.Int, len(a.coins))
+	for i := range amounts {
+		amounts[i] = new(big.Int)
+	}
+	amounts[sold].Set(exchange.TokensSold)
+	amounts[bought].Neg(exchange.TokensBought)
+	return &poolEvent{log: l, swap: &swapEvent{sender: exchange.Buyer, recipient: exchange.Buyer, amounts: amounts}}, nil
+}
+
+// Price is the marginal price on the StableSwap invariant, before fees.
+func (a *curveAdapter) Price(state *poolState, base int, quote int) *big.Rat {
+	xp := make([]*big.Int, len(state.reserves))
+	for i, balance := range state.reserves {
+		if balance.Sign() == 0 {
+			return new(big.Rat)
+		}
+		xp[i] = new(big.Int).Mul(balance, a.rates[i])
+	}
+	price := stableSwapPrice(xp, state.amplification, base, quote)
+	// Back from the common precision to raw units of each coin.
+	return price.Mul(price, new(big.Rat).SetFrac(a.rates[base], a.rates[quote]))
+}
+
+// stableSwapInvariant solves the StableSwap invariant
+//
+//	A·n·S + D = A·n·D + D^(n+1) / (n^n · Πx)
+//
+// for D by Newton's method, with the same integer steps as the pools.
+func stableSwapInvariant(xp []*big.Int, amplification *big.Int) *big.Int {
+	n := big.NewInt(int64(len(xp)))
+	sum := new(big.Int)
+	for _, x := range xp {
+		sum.Add(sum, x)
+	}
+	if sum.Sign() == 0 {
+		return sum
+	}
+	ann := new(big.Int).Mul(amplification, n)
+	d := new(big.Int).Set(sum)
+	one := big.NewInt(1)
+	for i := 0; i < 255; i++ {
+		dp := new(big.Int).Set(d)
+		for _, x := range xp {
+			dp.Mul(dp, d)
+			dp.Quo(dp, new(big.Int).Mul(x, n))
+		}
+		previous := new(big.Int).Set(d)
+		numerator := new(big.Int).Mul(ann, sum)
+		numerator.Add(numerator, new(big.Int).Mul(dp, n))
+		numerator.Mul(numerator, d)
+		denominator := new(big.Int).Mul(new(big.Int).Sub(ann, one), d)
+		denominator.Add(denominator, new(big.Int).Mul(new(big.Int).Add(n, one), dp))
+		d.Quo(numerator, denominator)
+		if new(big.Int).Sub(d, previous).CmpAbs(one) <= 0 {
+			break
+		}
+	}
+	return d
+}
+
+// stableSwapPrice returns the marginal price of coin base in coin quote from
+// the balances xp, all in a common precision. It is the ratio of the partial
+// derivatives of the invariant, A·n + D^(n+1) / (n^n · Πx · x_i).
+func stableSwapPrice(xp []*big.Int, amplification *big.Int, base int, quote int) *big.Rat {
+	d := stableSwapInvariant(xp, amplification)
+	if d.Sign() == 0 {
+		return new(big.Rat)
+	}
+	n := int64(len(xp))
+	ann := new(big.Rat).SetInt(new(big.Int).Mul(amplification, big.NewInt(n)))
+	dn1 := new(big.Int).Exp(d, big.NewInt(n+1), nil)
+	denominator := new(big.Int).Exp(big.NewInt(n), big.NewInt(n), nil)
+	for _, x := range xp {
+		denominator.Mul(denominator, x)
+	}
+	derivative := func(i int) *big.Rat {
+		term := new(big.Rat).SetFrac(dn1, new(big.Int).Mul(denominator, xp[i]))
+		return term.Add(term, ann)
+	}
+	return new(big.Rat).Quo(derivative(base), derivative(quote))
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// units returns amounts scaled to the 18 decimals Curve computes in.
+func units(amounts ...int64) []*big.Int {
+	xp := make([]*big.Int, len(amounts))
+	for i, amount := range amounts {
+		xp[i] = new(big.Int).Mul(big.NewInt(amount), pow10(18))
+	}
+	return xp
+}
+
+func TestStableSwapInvariant(t *testing.T) {
+	tests := []struct {
+		name          string
+		xp            []*big.Int
+		amplification int64
+	}{
+		{"balanced", units(1000000, 1000000), 100},
+		{"balanced three coins", units(500000, 500000, 500000), 2000},
+		{"imbalanced", units(1000000, 3000000), 100},
+		{"imbalanced three coins", units(1000, 250000, 4000000), 200},
+		{"low amplification", units(1000000, 9000000), 1},
+		{"empty", []*big.Int{new(big.Int), new(big.Int)}, 100},
+	}
+	for _, test := range tests {
+		amplification := big.NewInt(test.amplification)
+		d := stableSwapInvariant(test.xp, amplification)
+
+		sum := new(big.Int)
+		product := big.NewInt(1)
+		for _, x := range test.xp {
+			sum.Add(sum, x)
+			product.Mul(product, x)
+		}
+		if sum.Sign() == 0 {
+			if d.Sign() != 0 {
+				t.Errorf("%v: D = %v, want 0", test.name, d)
+			}
+			continue
+		}
+		if d.Cmp(sum) > 0 {
+			t.Errorf("%v: D = %v exceeds the sum of balances %v", test.name, d, sum)
+		}
+
+		// A·n·S + D and A·n·D + D^(n+1) / (n^n · Πx) agree up to rounding.
+		n := big.NewInt(int64(len(test.xp)))
+		ann := new(big.Int).Mul(amplification, n)
+		left := new(big.Int).Mul(ann, sum)
+		left.Add(left, d)
+		right := new(big.Int).Exp(d, new(big.Int).Add(n, big.NewInt(1)), nil)
+		right.Quo(right, new(big.Int).Mul(new(big.Int).Exp(n, n, nil), product))
+		right.Add(right, new(big.Int).Mul(ann, d))
+		residual, _ := new(big.Rat).SetFrac(new(big.Int).Sub(left, right), left).Float64()
+		if math.Abs(residual) > 1e-15 {
+			t.Errorf("%v: D = %v leaves a relative residual of %v", test.name, d, residual)
+		}
+	}
+}
+
+func TestStableSwapPrice(t *testing.T) {
+	tests := []struct {
+		name          string
+		xp            []*big.Int
+		amplification int64
+		base, quote   int
+	}{
+		{"balanced", units(1000000, 1000000), 100, 0, 1},
+		{"balanced three coins", units(500000, 500000, 500000), 2000, 2, 0},
+		{"imbalanced", units(1000000, 3000000), 100, 0, 1},
+		{"imbalanced inverse", units(1000000, 3000000), 100, 1, 0},
+		{"imbalanced three coins", units(1000, 250000, 4000000), 200, 0, 2},
+		{"low amplification", units(1000000, 9000000), 1, 1, 0},
+	}
+	for _, test := range tests {
+		amplification := big.NewInt(test.amplification)
+		got, _ := stableSwapPrice(test.xp, amplification, test.base, test.quote).Float64()
+
+		// The marginal price is how much of quote keeps the invariant when a
+		// little of base is added, taken from finite differences of
+		// F(x) = A·n·S + D - A·n·D - D^(n+1) / (n^n · Πx) at constant D.
+		d, _ := new(big.Float).SetInt(stableSwapInvariant(test.xp, amplification)).Float64()
+		x := make([]float64, len(test.xp))
+		for i, balance := range test.xp {
+			x[i], _ = new(big.Float).SetInt(balance).Float64()
+		}
+		n := float64(len(x))
+		ann := float64(test.amplification) * n
+		invariant := func(x []float64) float64 {
+			sum, product := 0.0, 1.0
+			for _, xi := range x {
+				sum += xi
+				product *= xi
+			}
+			return ann*sum + d - ann*d - math.Pow(d, n+1)/(math.Pow(n, n)*product)
+		}
+		partial := func(i int) float64 {
+			h := x[i] * 1e-7
+			up := append([]float64(nil), x...)
+			down := append([]float64(nil), x...)
+			up[i] += h
+			down[i] -= h
+			return (invariant(up) - invariant(down)) / (2 * h)
+		}
+		want := partial(test.base) / partial(test.quote)
+
+		if math.Abs(got-want) > 1e-6*want {
+			t.Errorf("%v: stableSwapPrice = %v, want %v", test.name, got, want)
+		}
+		balanced := true
+		for _, balance := range test.xp {
+			balanced = balanced && balance.Cmp(test.xp[0]) == 0
+		}
+		if balanced && got != 1 {
+			t.Errorf("%v: balanced pool prices %v, want 1", test.name, got)
+		}
+		if test.xp[test.base].Cmp(test.xp[test.quote]) > 0 && got >= 1 {
+			t.Errorf("%v: the more abundant coin prices %v, want below 1", test.name, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	uniswapV3Factory "github.com/toamto94/dex-streamer.git/pkg/abigen/uniswapV3Factory"
+	uniswapV3Pair "github.com/toamto94/dex-streamer.git/pkg/abigen/uniswapV3Pair"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+)
+
+// feeTiers are the fee amounts looked up for a token pair. A factory only
+// deploys pools for the amounts it enabled, which have a tick spacing.
+var feeTiers = []int64{100, 500, 2500, 3000, 10000}
+
+var poolCreatedTopic = eventTopic(uniswapV3Factory.UniswapV3FactoryAbigenMetaData, "PoolCreated")
+
+// poolFactory is a Uniswap V3 style factory pools are discovered from.
+type poolFactory struct {
+	contractReader
+	instance *uniswapV3Factory.UniswapV3FactoryAbigen
+	tokens   []common.Address // tokens the query asked for
+}
+
+func (server *DEXStreamerServerImp) dialFactory(query *proto.PoolQuery) (*poolFactory, error) {
+	client, chain, err := server.dialChain(query.Chain, query.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	address, ok := chain.factory(defaultDEX)
+	if query.Factory != "" {
+		if !common.IsHexAddress(query.Factory) {
+			return nil, status.Errorf(codes.InvalidArgument, "%q is not a factory address", query.Factory)
+		}
+		address = common.HexToAddress(query.Factory)
+	} else if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "no Uniswap V3 factory is known on %v", chain.name)
+	}
+	instance, err := uniswapV3Factory.NewUniswapV3FactoryAbigen(address, client)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "factory instance could not be bound - %v", err)
+	}
+
+	f := &poolFactory{contractReader: contractReader{backend: client, chain: chain, address: address}, instance: instance}
+	for _, reference := range []string{query.TokenA, query.TokenB} {
+		if reference == "" {
+			continue
+		}
+		token, ok := chain.token(reference)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown token %q on %v", reference, chain.name)
+		}
+		f.tokens = append(f.tokens, token)
+	}
+	if len(f.tokens) == 2 && f.tokens[0] == f.tokens[1] {
+		return nil, status.Errorf(codes.InvalidArgument, "both tokens are %v", f.tokens[0])
+	}
+	return f, nil
+}
+
+// pair returns the queried tokens in the order the factory sorts them.
+func (f *poolFactory) pair() (common.Address, common.Address) {
+	if bytes.Compare(f.tokens[0].Bytes(), f.tokens[1].Bytes()) > 0 {
+		return f.tokens[1], f.tokens[0]
+	}
+	return f.tokens[0], f.tokens[1]
+}
+
+// FindPools returns the pools of every fee tier the factory deployed for two tokens.
+func (server *DEXStreamerServerImp) FindPools(ctx context.Context, query *proto.PoolQuery) (*proto.Pools, error) {
+	f, err := server.dialFactory(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.tokens) != 2 {
+		return nil, status.Error(codes.InvalidArgument, "pools can only be found for two tokens")
+	}
+	token0, token1 := f.pair()
+
+	blocknumber, err := f.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "blocknumber could not be fetched - %v", err)
+	}
+	callOpts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(blocknumber), Context: ctx}
+
+	pools := &proto.Pools{}
+	for _, fee := range feeTiers {
+		tickSpacing, err := f.instance.FeeAmountTickSpacing(callOpts, big.NewInt(fee))
+		if err != nil {
+			return nil, upstreamStatus(codes.FailedPrecondition, err, "tick spacing could not be fetched, is this a Uniswap V3 factory?")
+		}
+		if tickSpacing.Sign() == 0 {
+			continue
+		}
+		address, err := f.instance.GetPool(callOpts, token0, token1, big.NewInt(fee))
+		if err != nil {
+			return nil, upstreamStatus(codes.FailedPrecondition, err, fmt.Sprintf("pool of fee %v could not be fetched", fee))
+		}
+		if address == (common.Address{}) {
+			continue
+		}
+		pairInstance, err := uniswapV3Pair.NewUniswapV3PairAbigen(address, f.backend)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "pool instance could not be bound - %v", err)
+		}
+		liquidity, err := pairInstance.Liquidity(callOpts)
+		if err != nil {
+			return nil, upstreamStatus(codes.FailedPrecondition, err, fmt.Sprintf("liquidity of pool %v could not be fetched", address))
+		}
+		pools.Pools = append(pools.Pools, &proto.Pool{
+			Address:     address.Hex(),
+			Token0:      token0.Hex(),
+			Token1:      token1.Hex(),
+			Fee:         uint32(fee),
+			TickSpacing: int32(tickSpacing.Int64()),
+			Liquidity:   liquidity.String(),
+			Blocknumber: blocknumber,
+			Factory:     f.address.Hex(),
+		})
+	}
+	return pools, nil
+}
+
+// StreamNewPools streams the pools the factory creates, optionally only those
+// of the queried tokens.
+func (server *DEXStreamerServerImp) StreamNewPools(query *proto.PoolQuery, stream proto.DEXStreamer_StreamNewPoolsServer) error {
+	ctx := stream.Context()
+	f, err := server.dialFactory(query)
+	if err != nil {
+		return err
+	}
+
+	// The follower reads the chain defaults for confirmations and polling from a contract.
+	contract := &proto.Contract{Endpoint: query.Endpoint, Chain: query.Chain, Address: f.address.Hex(), FromBlock: query.FromBlock}
+	from, replay := startPosition(contract)
+	if !replay {
+		head, err := f.head(ctx, contract)
+		if err != nil {
+			return streamStatus(err)
+		}
+		from = logPosition{block: head.number + 1}
+	}
+
+	filter := ethereum.FilterQuery{Addresses: []common.Address{f.address}, Topics: [][]common.Hash{{poolCreatedTopic}}}
+	if len(f.tokens) == 2 {
+		token0, token1 := f.pair()
+		filter.Topics = append(filter.Topics, []common.Hash{token0.Hash()}, []common.Hash{token1.Hash()})
+	}
+
+	return streamStatus(f.followEvents(ctx, contract, filter, from, func(l types.Log) error {
+		created, err := f.instance.ParsePoolCreated(l)
+		if err != nil {
+			return fmt.Errorf("log %v of block %v could not be decoded - %w", l.Index, l.BlockNumber, err)
+		}
+		// A single token may be either side of the pair, which topics cannot express.
+		if len(f.tokens) == 1 && created.Token0 != f.tokens[0] && created.Token1 != f.tokens[0] {
+			return nil
+		}
+		return stream.Send(&proto.Pool{
+			Address:     created.Pool.Hex(),
+			Token0:      created.Token0.Hex(),
+			Token1:      created.Token1.Hex(),
+			Fee:         uint32(created.Fee.Uint64()),
+			TickSpacing: int32(created.TickSpacing.Int64()),
+			Blocknumber: l.BlockNumber,
+			TxHash:      l.TxHash.Hex(),
+			Factory:     f.address.Hex(),
+		})
+	}, func(retraction *proto.Retraction) error {
+		return stream.Send(&proto.Pool{Factory: f.address.Hex(), Retraction: retraction})
+	}))
+}
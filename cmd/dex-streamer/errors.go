@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+// stateAttempts is how often a failing state read is tried before the stream gives up.
+const stateAttempts = 4
+
+// upstreamStatus turns a failed node call into a gRPC status. If the node
+// answered with an error, or with data that does not decode, the contract is
+// not what the client claims it is and code applies. Anything else means the
+// node could not be reached.
+func upstreamStatus(code codes.Code, err error, message string) error {
+	if rejected(err) {
+		return status.Errorf(code, "%s - %v", message, err)
+	}
+	return status.Errorf(codes.Unavailable, "%s - %v", message, err)
+}
+
+// rejected tells whether a call reached the node but the contract did not
+// answer it, because it reverted, has no code or returned data that does not
+// decode.
+func rejected(err error) bool {
+	return reverted(err) || errors.Is(err, bind.ErrNoCode) || strings.Contains(err.Error(), "abi:")
+}
+
+// reverted tells whether a call failed because the node rejected it, as
+// opposed to the node not being reached.
+func reverted(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) || errors.Is(err, errBatchedCallReverted)
+}
+
+// streamStatus makes sure the error a stream ends with carries a gRPC code.
+func streamStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// retry calls read until it succeeds, backing off exponentially between
+// attempts. It gives up after the given number of attempts or once ctx is done.
+func retry(ctx context.Context, attempts int, read func() error) error {
+	backoff := 250 * time.Millisecond
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = read(); err == nil || attempt == attempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strings"
+	"sync"
+)
+
+// hubKey identifies an upstream price feed. Streams with the same key share a
+// single reader no matter how many clients are attached. Streams asking for a
+// different block tag, confirmation depth, price precision or token pair see
+// different updates and get their own feed.
+type hubKey struct {
+	provider      string
+	chain         string
+	dex           string
+	address       common.Address
+	blockTag      string
+	confirmations uint32
+	precision     uint32
+	baseToken     string
+	quoteToken    string
+	unverified    bool
+}
+
+// reader produces the updates of one feed until ctx is cancelled or it fails.
+type reader func(ctx context.Context, contract *proto.Contract, publish func(*proto.Response)) error
+
+// hub runs one upstream reader per hubKey and fans its updates out to every
+// attached subscriber.
+type hub struct {
+	mu         sync.Mutex
+	feeds      map[hubKey]*feed
+	read       reader
+	bufferSize int
+}
+
+type feed struct {
+	key         hubKey
+	cancel      context.CancelFunc
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	last        *proto.Response
+}
+
+// subscriber receives the updates of a feed. Once done is closed, err holds the
+// reason the upstream reader stopped or the subscriber was ended.
+type subscriber struct {
+	feed    *feed
+	updates chan *proto.Response
+	done    chan struct{}
+	err     error
+}
+
+func newHub(read reader, bufferSize int) *hub {
+	return &hub{feeds: make(map[hubKey]*feed), read: read, bufferSize: bufferSize}
+}
+
+func keyOf(contract *proto.Contract) hubKey {
+	return hubKey{
+		provider:      strings.ToLower(contract.Endpoint),
+		chain:         strings.ToLower(contract.Chain),
+		dex:           strings.ToLower(contract.Dex),
+		address:       common.HexToAddress(contract.Address),
+		blockTag:      strings.ToLower(contract.BlockTag),
+		confirmations: contract.Confirmations,
+		precision:     contract.Precision,
+		baseToken:     strings.ToLower(contract.BaseToken),
+		quoteToken:    strings.ToLower(contract.QuoteToken),
+		unverified:    contract.AllowUnverified,
+	}
+}
+
+// subscribe attaches to the feed for contract, starting its reader if this is
+// the first subscriber. The feed keeps the scrape interval of the subscriber
+// that started it.
+func (h *hub) subscribe(contract *proto.Contract) *subscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := keyOf(contract)
+	f, ok := h.feeds[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		f = &feed{key: key, cancel: cancel, subscribers: make(map[*subscriber]struct{})}
+		h.feeds[key] = f
+		go h.run(ctx, f, contract)
+		log.Printf("Started upstream feed for %v", key.address)
+	}
+
+	s := &subscriber{feed: f, updates: make(chan *proto.Response, h.bufferSize), done: make(chan struct{})}
+	f.mu.Lock()
+	f.subscribers[s] = struct{}{}
+	if f.last != nil {
+		s.updates <- f.last
+	}
+	f.mu.Unlock()
+	return s
+}
+
+// unsubscribe detaches s and tears the feed down once nobody is left.
+func (h *hub) unsubscribe(s *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f := s.feed
+	f.mu.Lock()
+	delete(f.subscribers, s)
+	remaining := len(f.subscribers)
+	f.mu.Unlock()
+
+	if remaining == 0 && h.feeds[f.key] == f {
+		delete(h.feeds, f.key)
+		f.cancel()
+		log.Printf("Stopped upstream feed for %v", f.key.address)
+	}
+}
+
+func (h *hub) run(ctx context.Context, f *feed, contract *proto.Contract) {
+	err := h.read(ctx, contract, f.publish)
+	if err != nil {
+		log.Printf("Upstream feed for %v stopped - %v", f.key.address, err)
+	}
+
+	h.mu.Lock()
+	if h.feeds[f.key] == f {
+		delete(h.feeds, f.key)
+	}
+	h.mu.Unlock()
+
+	f.mu.Lock()
+	for s := range f.subscribers {
+		s.err = err
+		close(s.done)
+	}
+	f.subscribers = nil
+	f.mu.Unlock()
+	f.cancel()
+}
+
+// publish hands response to every subscriber. A subscriber that falls behind
+// loses its oldest buffered update rather than stalling the feed, unless that
+// update is a retraction: prices it withdraws would then stand, so the
+// subscriber is ended instead. Late joiners start from the last price, never
+// from a retraction or a price it withdrew.
+func (f *feed) publish(response *proto.Response) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if retraction := response.Retraction; retraction == nil {
+		f.last = response
+	} else if f.last != nil && f.last.GetCursor().GetBlocknumber() >= retraction.Blocknumber {
+		f.last = nil
+	}
+	for s := range f.subscribers {
+		select {
+		case s.updates <- response:
+			continue
+		default:
+		}
+		select {
+		case oldest := <-s.updates:
+			if oldest.Retraction != nil {
+				s.err = status.Error(codes.ResourceExhausted, "subscriber fell behind a reorg retraction")
+				close(s.done)
+				delete(f.subscribers, s)
+				continue
+			}
+		default:
+		}
+		s.updates <- response
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// testReader stands in for readPrices. Every feed it is started for signals
+// started and blocks until cancelled or failed.
+type testReader struct {
+	started chan struct{}
+	stopped chan struct{}
+	fail    chan error
+}
+
+func newTestReader() *testReader {
+	return &testReader{started: make(chan struct{}, 8), stopped: make(chan struct{}, 8), fail: make(chan error)}
+}
+
+func (r *testReader) read(ctx context.Context, contract *proto.Contract, publish func(*proto.Response)) error {
+	r.started <- struct{}{}
+	defer func() { r.stopped <- struct{}{} }()
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-r.fail:
+		return err
+	}
+}
+
+func receive(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+func TestHubLifecycle(t *testing.T) {
+	pool := &proto.Contract{Chain: "ethereum", Address: "0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640"}
+	precise := &proto.Contract{Chain: "ethereum", Address: pool.Address, Precision: 30}
+
+	r := newTestReader()
+	h := newHub(r.read, 4)
+	first := h.subscribe(pool)
+	receive(t, r.started)
+	second := h.subscribe(&proto.Contract{Chain: "Ethereum", Address: "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640"})
+	third := h.subscribe(precise)
+	receive(t, r.started)
+	select {
+	case <-r.started:
+		t.Fatal("a feed was started twice")
+	default:
+	}
+	if len(h.feeds) != 2 {
+		t.Fatalf("hub runs %v feeds, want 2", len(h.feeds))
+	}
+
+	h.unsubscribe(first)
+	select {
+	case <-r.stopped:
+		t.Fatal("feed stopped while a subscriber was left")
+	case <-time.After(10 * time.Millisecond):
+	}
+	h.unsubscribe(second)
+	receive(t, r.stopped)
+	h.unsubscribe(third)
+	receive(t, r.stopped)
+	if len(h.feeds) != 0 {
+		t.Fatalf("hub runs %v feeds after every subscriber left", len(h.feeds))
+	}
+}
+
+func TestHubReaderFailure(t *testing.T) {
+	r := newTestReader()
+	h := newHub(r.read, 4)
+	s := h.subscribe(&proto.Contract{Chain: "ethereum"})
+	receive(t, r.started)
+
+	failure := errors.New("upstream gone")
+	r.fail <- failure
+	receive(t, s.done)
+	if s.err != failure {
+		t.Fatalf("subscriber ended with %v, want %v", s.err, failure)
+	}
+	receive(t, r.stopped)
+	h.unsubscribe(s)
+}
+
+func TestFeedPublish(t *testing.T) {
+	price := func(block uint64) *proto.Response {
+		return &proto.Response{Blocknumber: int32(block), Cursor: cursorOf(logPosition{block: block, index: endOfBlock})}
+	}
+	retraction := func(block uint64) *proto.Response {
+		return &proto.Response{Retraction: &proto.Retraction{Blocknumber: block}}
+	}
+	tests := []struct {
+		name      string
+		published []*proto.Response
+		buffered  []*proto.Response // what the subscriber holds afterwards, nil if it was ended
+		last      *proto.Response
+	}{
+		{"buffered", []*proto.Response{price(1)}, []*proto.Response{price(1)}, price(1)},
+		{"oldest price dropped", []*proto.Response{price(1), price(2), price(3)}, []*proto.Response{price(2), price(3)}, price(3)},
+		{"retraction never dropped", []*proto.Response{retraction(1), price(2), price(3)}, nil, price(3)},
+		{"retraction withdraws last price", []*proto.Response{price(5), retraction(5)}, []*proto.Response{price(5), retraction(5)}, nil},
+		{"retraction keeps older price", []*proto.Response{price(4), retraction(5)}, []*proto.Response{price(4), retraction(5)}, price(4)},
+		// Block numbers past the int32 range only survive in the cursor.
+		{"retraction beyond int32", []*proto.Response{price(1 << 32), retraction(1 << 32)}, []*proto.Response{price(1 << 32), retraction(1 << 32)}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := &feed{subscribers: make(map[*subscriber]struct{})}
+			s := &subscriber{feed: f, updates: make(chan *proto.Response, 2), done: make(chan struct{})}
+			f.subscribers[s] = struct{}{}
+			for _, response := range test.published {
+				f.publish(response)
+			}
+
+			if test.buffered == nil {
+				receive(t, s.done)
+				if status.Code(s.err) != codes.ResourceExhausted {
+					t.Fatalf("subscriber ended with %v, want ResourceExhausted", s.err)
+				}
+				if _, ok := f.subscribers[s]; ok {
+					t.Fatal("ended subscriber is still attached")
+				}
+			} else {
+				if len(s.updates) != len(test.buffered) {
+					t.Fatalf("subscriber holds %v updates, want %v", len(s.updates), len(test.buffered))
+				}
+				for _, want := range test.buffered {
+					if got := <-s.updates; got.GetCursor().GetBlocknumber() != want.GetCursor().GetBlocknumber() || (got.Retraction == nil) != (want.Retraction == nil) {
+						t.Fatalf("subscriber got %v, want %v", got, want)
+					}
+				}
+			}
+			if (f.last == nil) != (test.last == nil) || f.last != nil && f.last.Cursor.Blocknumber != test.last.Cursor.Blocknumber {
+				t.Fatalf("feed replays %v, want %v", f.last, test.last)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	multicall3 "github.com/toamto94/dex-streamer.git/pkg/abigen/multicall3"
+)
+
+// multicall3Address is where Multicall3 is deployed on every supported chain.
+var multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// errBatchedCallReverted is the error of a batched read the contract reverted.
+var errBatchedCallReverted = errors.New("execution reverted")
+
+// multicall batches contract reads into a single eth_call of Multicall3's
+// aggregate3, so they cost one round-trip and all read the same block.
+type multicall struct {
+	calls   []multicall3.Multicall3Call3
+	results []*batchedCall
+}
+
+// batchedCall is a read queued in a multicall. Its outputs are set once the
+// batch ran, err if this read failed.
+type batchedCall struct {
+	abi    *abi.ABI
+	method string
+	out    []interface{}
+	err    error
+}
+
+// add queues a call of method on target, described by the metadata of its binding.
+func (m *multicall) add(target common.Address, metadata *bind.MetaData, method string, args ...interface{}) *batchedCall {
+	call := &batchedCall{method: method}
+	m.results = append(m.results, call)
+	if call.abi, call.err = metadata.GetAbi(); call.err != nil {
+		return call
+	}
+	data, err := call.abi.Pack(method, args...)
+	if err != nil {
+		call.err = err
+		return call
+	}
+	m.calls = append(m.calls, multicall3.Multicall3Call3{Target: target, AllowFailure: true, CallData: data})
+	return call
+}
+
+// run executes the queued reads. Single reads may fail without failing the
+// batch; only an unreachable node does.
+func (m *multicall) run(opts *bind.CallOpts, backend bind.ContractCaller) error {
+	if len(m.calls) == 0 {
+		return nil
+	}
+	msg, err := m.message()
+	if err != nil {
+		return err
+	}
+	output, err := backend.CallContract(opts.Context, msg, opts.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("multicall could not be executed - %w", err)
+	}
+	return m.decode(output)
+}
+
+// message is the eth_call of aggregate3 that executes the queued reads.
+func (m *multicall) message() (ethereum.CallMsg, error) {
+	contract, err := multicall3.Multicall3AbigenMetaData.GetAbi()
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	data, err := contract.Pack("aggregate3", m.calls)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{To: &multicall3Address, Data: data}, nil
+}
+
+// decode hands the output of aggregate3 out to the queued reads. An empty
+// output means Multicall3 is not deployed at the block read.
+func (m *multicall) decode(output []byte) error {
+	if len(output) == 0 {
+		return bind.ErrNoCode
+	}
+	contract, err := multicall3.Multicall3AbigenMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	values, err := contract.Unpack("aggregate3", output)
+	if err != nil {
+		return fmt.Errorf("multicall could not be decoded - %w", err)
+	}
+	results := *abi.ConvertType(values[0], new([]multicall3.Multicall3Result)).(*[]multicall3.Multicall3Result)
+	i := 0
+	for _, call := range m.results {
+		if call.err != nil {
+			continue // never queued
+		}
+		if i >= len(results) {
+			return fmt.Errorf("multicall returned %v results for %v calls", len(results), len(m.calls))
+		}
+		if result := results[i]; result.Success {
+			call.out, call.err = call.abi.Unpack(call.method, result.ReturnData)
+		} else {
+			call.err = errBatchedCallReverted
+		}
+		i++
+	}
+	return nil
+}
@@ -0,0 +1,694 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	multicall3 "github.com/toamto94/dex-streamer.git/pkg/abigen/multicall3"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// token is a token traded in a pool.
+type token struct {
+	address    common.Address
+	name       string
+	symbol     string
+	decimals   uint8
+	interfaces []string // probed interfaces, see probeInterfaces
+}
+
+// label names the token in price updates, by symbol if it has one.
+func (t token) label() string {
+	if t.symbol == "" {
+		return t.address.Hex()
+	}
+	return t.symbol
+}
+
+// matches tells whether a client-supplied token reference, an address or a
+// symbol, names the token.
+func (t token) matches(reference string) bool {
+	if common.IsHexAddress(reference) {
+		return common.HexToAddress(reference) == t.address
+	}
+	return t.symbol != "" && strings.EqualFold(reference, t.symbol)
+}
+
+// blockTimeCacheSize bounds the block timestamps a reader remembers.
+const blockTimeCacheSize = 256
+
+// contractReader reads and follows one contract through the providers of its chain.
+type contractReader struct {
+	backend *providerPool
+	chain   *chainInfo
+	address common.Address
+
+	timesMu sync.Mutex
+	times   map[common.Hash]time.Time // timestamps of recently seen blocks
+}
+
+// pool bundles the connection, DEX adapter and token metadata a stream needs.
+type pool struct {
+	contractReader
+	dex       string
+	adapter   DEXAdapter
+	tokens    []token
+	base      int // index of the token prices are given for
+	quote     int // index of the token prices are quoted in
+	precision int
+	verified  bool // deployed by the chain's canonical factory
+}
+
+// dialPool binds a pool at the current block. Adapters that can queue their
+// reads find the block, probe the pool's variant and read its tokens, factory
+// and fee in one multicall, the others take a call each. The metadata of
+// uncached tokens follows in a second multicall, as it needs the token
+// addresses.
+func (server *DEXStreamerServerImp) dialPool(ctx context.Context, contract *proto.Contract) (*pool, error) {
+	if !common.IsHexAddress(contract.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is not a contract address", contract.Address)
+	}
+	if contract.Precision > maxPrecision {
+		return nil, status.Errorf(codes.InvalidArgument, "precision %v exceeds %v decimal places", contract.Precision, maxPrecision)
+	}
+	if !knownBlockTag(contract.BlockTag) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown block tag %q", contract.BlockTag)
+	}
+	dex, newAdapter, err := lookupAdapter(contract.Dex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	client, chain, err := server.dialChain(contract.Chain, contract.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	address := common.HexToAddress(contract.Address)
+
+	callOpts := bind.CallOpts{Context: ctx}
+	adapter, err := bindAdapter(&callOpts, dex, newAdapter, address, client)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := adapter.Tokens(&callOpts)
+	if err != nil {
+		return nil, upstreamStatus(codes.FailedPrecondition, err, fmt.Sprintf("tokens could not be fetched, is this a %v pool?", dex))
+	}
+	if len(addresses) < 2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "pool %v trades %v tokens, is this a %v pool?", address, len(addresses), dex)
+	}
+
+	tokens, err := server.tokens.lookup(&callOpts, chain, client, addresses...)
+	if err != nil {
+		return nil, upstreamStatus(codes.FailedPrecondition, err, "tokens could not be read")
+	}
+
+	p := &pool{
+		contractReader: contractReader{backend: client, chain: chain, address: address},
+		dex:            dex,
+		adapter:        adapter,
+		tokens:         tokens,
+		precision:      precision(contract),
+	}
+	if err := p.verify(&callOpts, contract); err != nil {
+		return nil, err
+	}
+	if p.base, err = p.tokenIndex(contract.BaseToken); err != nil {
+		return nil, err
+	}
+	if p.quote, err = p.tokenIndex(contract.QuoteToken); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.base < 0 && p.quote < 0:
+		p.base, p.quote = 0, 1
+	case p.base < 0:
+		p.base = otherToken(p.quote)
+	case p.quote < 0:
+		p.quote = otherToken(p.base)
+	case p.base == p.quote:
+		return nil, status.Errorf(codes.InvalidArgument, "base and quote token are both %v", p.tokens[p.base].label())
+	}
+	return p, nil
+}
+
+// bindAdapter binds the adapter of the pool at address at the current block,
+// which it pins opts to.
+func bindAdapter(opts *bind.CallOpts, dex string, newAdapter adapterFactory, address common.Address, client *providerPool) (DEXAdapter, error) {
+	if queue, ok := batchAdapters[dex]; ok {
+		batch := &multicall{}
+		blocknumber := batch.add(multicall3Address, multicall3.Multicall3AbigenMetaData, "getBlockNumber")
+		assemble := queue(batch, address, client)
+		err := batch.run(opts, client)
+		if err == nil {
+			err = blocknumber.err
+		}
+		if err == nil {
+			opts.BlockNumber = blocknumber.out[0].(*big.Int)
+			log.Printf("Current block number: %v", opts.BlockNumber)
+			adapter, err := assemble()
+			if err != nil {
+				return nil, upstreamStatus(codes.FailedPrecondition, err, fmt.Sprintf("pool could not be bound, is this a %v pool?", dex))
+			}
+			return adapter, nil
+		}
+		if !errors.Is(err, bind.ErrNoCode) {
+			return nil, status.Errorf(codes.Unavailable, "pool could not be bound - %v", err)
+		}
+		// Multicall3 is not deployed where the provider reads, probe a call at a time.
+	}
+
+	blocknumber, err := client.BlockNumber(opts.Context)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "blocknumber could not be fetched - %v", err)
+	}
+	log.Printf("Current block number: %v", blocknumber)
+	opts.BlockNumber = new(big.Int).SetUint64(blocknumber)
+
+	adapter, err := newAdapter(opts, address, client)
+	if err != nil {
+		return nil, upstreamStatus(codes.FailedPrecondition, err, fmt.Sprintf("pool could not be bound, is this a %v pool?", dex))
+	}
+	return adapter, nil
+}
+
+// verify checks that the pool is the dex the client asked for and was deployed
+// by the chain's canonical factory: it must name that factory and sit at the
+// address the factory deploys its tokens and fee to. Anyone can deploy a
+// contract with the interface of a pool, so pools failing the check are
+// refused unless the client allows them.
+func (p *pool) verify(opts *bind.CallOpts, contract *proto.Contract) error {
+	d, ok := p.adapter.(deployer)
+	if !ok {
+		return nil
+	}
+	deployment, err := d.Deployment(opts)
+	if err != nil {
+		return upstreamStatus(codes.FailedPrecondition, err, "deployment could not be verified")
+	}
+	if deployment == nil {
+		return nil
+	}
+	requested := p.dex
+	if alias, ok := dexAliases[requested]; ok {
+		requested = alias
+	}
+	canonical, known := p.chain.factory(deployment.dex)
+
+	var problem string
+	switch {
+	case deployment.dex != requested:
+		problem = fmt.Sprintf("pool %v is a %v pool, not %v", p.address, deployment.dex, p.dex)
+	case !known:
+		return nil
+	case deployment.factory != canonical:
+		problem = fmt.Sprintf("pool %v names factory %v instead of %v", p.address, deployment.factory, canonical)
+	case deployment.address != p.address:
+		problem = fmt.Sprintf("factory %v deploys the tokens and fee of pool %v to %v", canonical, p.address, deployment.address)
+	default:
+		p.verified = true
+		return nil
+	}
+	if !contract.AllowUnverified {
+		return status.Errorf(codes.FailedPrecondition, "%s, set allowUnverified to stream it anyway", problem)
+	}
+	log.Printf("Streaming unverified pool - %s", problem)
+	return nil
+}
+
+// tokenIndex resolves a client-supplied token reference, -1 if it is empty.
+func (p *pool) tokenIndex(reference string) (int, error) {
+	if reference == "" {
+		return -1, nil
+	}
+	for i, t := range p.tokens {
+		if t.matches(reference) {
+			return i, nil
+		}
+	}
+	return -1, status.Errorf(codes.InvalidArgument, "token %v is not traded in pool %v", reference, p.address)
+}
+
+// otherToken picks the counterpart of token i when the client named only one:
+// token1 for token0 and token0 for any other.
+func otherToken(i int) int {
+	if i == 0 {
+		return 1
+	}
+	return 0
+}
+
+// dialChain returns the providers a client asked for on a chain.
+func (server *DEXStreamerServerImp) dialChain(name string, provider string) (*providerPool, *chainInfo, error) {
+	chain, ok := lookupChain(name)
+	if !ok {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unknown chain %q", name)
+	}
+	client, err := server.providers.pool(chain, provider)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return client, chain, nil
+}
+
+func knownBlockTag(tag string) bool {
+	switch strings.ToLower(tag) {
+	case "", "latest", "safe", "finalized":
+		return true
+	}
+	return false
+}
+
+// confirmations returns the depth the stream reads at. Polled streams that
+// name neither a depth nor a block tag get the chain's default; an explicit
+// "latest" reads the chain head itself.
+func (r *contractReader) confirmations(contract *proto.Contract) uint32 {
+	if contract.Confirmations == 0 && contract.BlockTag == "" && !r.pushed(contract) {
+		return r.chain.confirmations
+	}
+	return contract.Confirmations
+}
+
+// confirmed tells whether the stream asked for state that is unlikely to be
+// rolled back, which rules out reacting to freshly pushed events.
+func confirmed(contract *proto.Contract) bool {
+	tag := strings.ToLower(contract.BlockTag)
+	return contract.Confirmations > 0 || tag == "safe" || tag == "finalized"
+}
+
+// pushed tells whether a websocket provider pushes the stream's events. Pushed
+// events are delivered as soon as they are mined and retracted if a reorg
+// removes them, so the chain's default depth does not hold them back.
+func (r *contractReader) pushed(contract *proto.Contract) bool {
+	return r.backend.canSubscribe() && !confirmed(contract)
+}
+
+// scrapeInterval returns how often the stream polls, once per block if the
+// client did not say.
+func (r *contractReader) scrapeInterval(contract *proto.Contract) time.Duration {
+	if contract.ScrapeInterval == 0 {
+		return r.chain.blockTime
+	}
+	return time.Millisecond * time.Duration(contract.ScrapeInterval)
+}
+
+// head returns the newest block the stream may read from: the block named by
+// the stream's tag, minus the requested number of confirmations.
+func (r *contractReader) head(ctx context.Context, contract *proto.Contract) (*blockHeader, error) {
+	header, err := r.tagged(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	depth := uint64(r.confirmations(contract))
+	if depth == 0 {
+		return header, nil
+	}
+	confirmed, err := r.confirmedNumber(header, depth)
+	if err != nil {
+		return nil, err
+	}
+	header, err = r.backend.BlockHeader(ctx, hexutil.EncodeBig(confirmed))
+	if err != nil {
+		return nil, fmt.Errorf("confirmed head could not be fetched - %w", err)
+	}
+	return header, nil
+}
+
+// tagged fetches the header of the block the stream's block tag points at.
+func (r *contractReader) tagged(ctx context.Context, contract *proto.Contract) (*blockHeader, error) {
+	header, err := r.backend.BlockHeader(ctx, blockTag(contract))
+	if err != nil {
+		return nil, fmt.Errorf("head could not be fetched - %w", err)
+	}
+	return header, nil
+}
+
+// blockTag is the tag of the block a stream follows, latest unless it asked for another.
+func blockTag(contract *proto.Contract) string {
+	if tag := strings.ToLower(contract.BlockTag); tag != "" {
+		return tag
+	}
+	return "latest"
+}
+
+func (r *contractReader) confirmedNumber(header *blockHeader, depth uint64) (*big.Int, error) {
+	if header.number < depth {
+		return nil, fmt.Errorf("chain is shorter than %v confirmations", depth)
+	}
+	return new(big.Int).SetUint64(header.number - depth), nil
+}
+
+// subscribe follows the logs selected by query when a websocket provider is
+// available. HTTP-only providers cannot push logs and pushed logs are
+// unconfirmed, so a nil subscription tells the caller to poll.
+func (r *contractReader) subscribe(ctx context.Context, contract *proto.Contract, query ethereum.FilterQuery, sink chan<- types.Log) (ethereum.Subscription, error) {
+	if !r.pushed(contract) {
+		return nil, nil
+	}
+	subscription, err := r.backend.SubscribeFilterLogs(ctx, query, sink)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "log subscription could not be established - %v", err)
+	}
+	log.Printf("Subscribed to the logs of %v", r.address)
+	return subscription, nil
+}
+
+// resubscribe replaces a dropped subscription, possibly through another
+// provider. The dropped one is returned along with any error, so that the
+// caller always holds a subscription it can unsubscribe.
+func (r *contractReader) resubscribe(ctx context.Context, contract *proto.Contract, query ethereum.FilterQuery, sink chan<- types.Log,
+	dropped ethereum.Subscription) (ethereum.Subscription, error) {
+	subscription, err := r.subscribe(ctx, contract, query, sink)
+	if err != nil {
+		return dropped, err
+	}
+	if subscription == nil {
+		return dropped, status.Error(codes.Unavailable, "log subscription could not be established - no websocket provider left")
+	}
+	dropped.Unsubscribe()
+	return subscription, nil
+}
+
+// blockTime returns the timestamp of a block, reading its header unless a
+// recent log of the same block did.
+func (r *contractReader) blockTime(ctx context.Context, hash common.Hash) (time.Time, error) {
+	r.timesMu.Lock()
+	t, ok := r.times[hash]
+	r.timesMu.Unlock()
+	if ok {
+		return t, nil
+	}
+	var header *types.Header
+	err := retry(ctx, stateAttempts, func() error {
+		var err error
+		header, err = r.backend.HeaderByHash(ctx, hash)
+		return err
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("header of block %v could not be fetched - %w", hash, err)
+	}
+	t = time.Unix(int64(header.Time), 0)
+	r.timesMu.Lock()
+	if r.times == nil || len(r.times) >= blockTimeCacheSize {
+		r.times = make(map[common.Hash]time.Time)
+	}
+	r.times[hash] = t
+	r.timesMu.Unlock()
+	return t, nil
+}
+
+// state reads the pool state at block, retrying failed reads.
+func (p *pool) state(ctx context.Context, block *big.Int) (*poolState, error) {
+	callOpts := bind.CallOpts{
+		Pending:     false,
+		BlockNumber: block,
+		Context:     ctx,
+	}
+	var state *poolState
+	err := retry(ctx, stateAttempts, func() error {
+		var err error
+		state, err = p.readState(&callOpts)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Pool state could not be fetched, retrying - %v", err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, upstreamStatus(codes.Unavailable, err, "pool state could not be fetched")
+	}
+	return state, nil
+}
+
+// poll reads the head block of the stream and the pool state at it. At the
+// tagged block both go out in one JSON-RPC batch, and Multicall3 reports the
+// block its reads saw so that a block landing in between is caught and the
+// state read again. Following confirmations costs one round-trip more to find
+// the tagged block first. A nil header means the head could not be fetched.
+func (p *pool) poll(ctx context.Context, contract *proto.Contract) (*blockHeader, *poolState, error) {
+	reader, ok := p.adapter.(batchReader)
+	if !ok {
+		return p.pollSeparately(ctx, contract)
+	}
+	block := blockTag(contract)
+	if depth := uint64(p.confirmations(contract)); depth > 0 {
+		header, err := p.tagged(ctx, contract)
+		if err != nil {
+			return nil, nil, err
+		}
+		confirmed, err := p.confirmedNumber(header, depth)
+		if err != nil {
+			return nil, nil, err
+		}
+		block = hexutil.EncodeBig(confirmed)
+	}
+
+	batch := &multicall{}
+	assemble := p.queueState(batch, reader)
+	observed := batch.add(multicall3Address, multicall3.Multicall3AbigenMetaData, "getBlockNumber")
+	msg, err := batch.message()
+	if err != nil {
+		return nil, nil, err
+	}
+	var header *blockHeader
+	var output hexutil.Bytes
+	err = p.backend.BatchCallContext(ctx, []rpc.BatchElem{
+		{Method: "eth_getBlockByNumber", Args: []interface{}{block, false}, Result: &header},
+		{Method: "eth_call", Args: []interface{}{map[string]interface{}{"to": msg.To, "data": hexutil.Bytes(msg.Data)}, block}, Result: &output},
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, nil, err
+		}
+		log.Printf("Head and pool state could not be fetched in one batch, reading them separately - %v", err)
+		return p.pollSeparately(ctx, contract)
+	}
+	if header == nil {
+		return nil, nil, fmt.Errorf("head could not be fetched - %w", ethereum.NotFound)
+	}
+	if err := batch.decode(output); err != nil || observed.err != nil || !observed.out[0].(*big.Int).IsUint64() ||
+		observed.out[0].(*big.Int).Uint64() != header.number {
+		state, err := p.state(ctx, header.Number())
+		return header, state, err
+	}
+	state, err := assemble()
+	if err != nil {
+		return header, nil, upstreamStatus(codes.Unavailable, err, "pool state could not be fetched")
+	}
+	return header, state, nil
+}
+
+// pollSeparately is poll for adapters that cannot batch their reads.
+func (p *pool) pollSeparately(ctx context.Context, contract *proto.Contract) (*blockHeader, *poolState, error) {
+	header, err := p.head(ctx, contract)
+	if err != nil {
+		return nil, nil, err
+	}
+	state, err := p.state(ctx, header.Number())
+	return header, state, err
+}
+
+// readState reads the pool state and, for pools that quote trades, what one
+// base token buys. Adapters that can batch their reads cost one multicall,
+// except at blocks before Multicall3 was deployed.
+func (p *pool) readState(opts *bind.CallOpts) (*poolState, error) {
+	reader, ok := p.adapter.(batchReader)
+	if !ok {
+		return p.readStateDirect(opts)
+	}
+	batch := &multicall{}
+	assemble := p.queueState(batch, reader)
+	if err := batch.run(opts, p.backend); errors.Is(err, bind.ErrNoCode) {
+		return p.readStateDirect(opts)
+	} else if err != nil {
+		return nil, err
+	}
+	return assemble()
+}
+
+// queueState queues the reads of readState in batch.
+func (p *pool) queueState(batch *multicall, reader batchReader) func() (*poolState, error) {
+	assemble := reader.QueueState(batch)
+	var quote func() (*big.Int, error)
+	if q, ok := p.adapter.(batchQuoter); ok {
+		quote = q.QueueQuote(batch, p.base, p.quote, pow10(int64(p.tokens[p.base].decimals)))
+	}
+	return func() (*poolState, error) {
+		state, err := assemble()
+		if err != nil {
+			return nil, err
+		}
+		if quote != nil {
+			if state.quote, err = quote(); err != nil {
+				return nil, fmt.Errorf("quote could not be fetched - %w", err)
+			}
+		}
+		return state, nil
+	}
+}
+
+// readStateDirect reads the pool state and quote with one call per value.
+func (p *pool) readStateDirect(opts *bind.CallOpts) (*poolState, error) {
+	state, err := p.adapter.State(opts)
+	if err != nil {
+		return nil, err
+	}
+	if q, ok := p.adapter.(quoter); ok {
+		state.quote, err = q.Quote(opts, p.base, p.quote, pow10(int64(p.tokens[p.base].decimals)))
+	}
+	return state, err
+}
+
+func precision(contract *proto.Contract) int {
+	if contract.Precision == 0 {
+		return defaultPrecision
+	}
+	return int(contract.Precision)
+}
+
+// price returns the price of token base in token quote in whole tokens.
+func (p *pool) price(state *poolState, base int, quote int) *big.Rat {
+	return scalePrice(p.adapter.Price(state, base, quote), p.tokens[base].decimals, p.tokens[quote].decimals)
+}
+
+// priceResponse builds the update for a pool state, oriented towards the quote
+// token the client asked for. spotPrice keeps the pool's token1 per token0 for
+// clients that predate the exact representation.
+func (p *pool) priceResponse(state *poolState) *proto.Response {
+	price := p.price(state, p.base, p.quote)
+	inverse := new(big.Rat)
+	if price.Sign() != 0 {
+		inverse.Inv(price)
+	}
+	spotPrice, _ := p.price(state, 0, 1).Float64()
+	approximation, _ := price.Float64()
+	inverseApproximation, _ := inverse.Float64()
+	response := &proto.Response{Token0: p.tokens[0].name, Token1: p.tokens[1].name, TimeStamp: time.Now().String(),
+		ObservedAt: timestamppb.Now(),
+		SpotPrice:  float32(spotPrice),
+		ExactPrice: price.FloatString(p.precision), Price: approximation,
+		ExactInversePrice: inverse.FloatString(p.precision), InversePrice: inverseApproximation,
+		BaseToken: p.tokens[p.base].label(), QuoteToken: p.tokens[p.quote].label(), Verified: p.verified}
+	if state.sqrtPriceX96 != nil {
+		response.SqrtPriceX96 = state.sqrtPriceX96.String()
+	}
+	if state.tick != nil {
+		response.Tick = int32(state.tick.Int64())
+	}
+	if state.liquidity != nil {
+		response.Liquidity = state.liquidity.String()
+	}
+	for i, reserve := range state.reserves {
+		response.Reserves = append(response.Reserves, scaleAmount(reserve, p.tokens[i].decimals))
+	}
+	if state.quote != nil {
+		response.EffectivePrice = scaleAmount(state.quote, p.tokens[p.quote].decimals)
+	}
+	return response
+}
+
+// headResponse builds the update for the state after the whole block head.
+func (p *pool) headResponse(head *blockHeader, state *poolState) *proto.Response {
+	response := p.priceResponse(state)
+	response.BlockTime = timestamppb.New(time.Unix(int64(head.time), 0))
+	response.Blocknumber = int32(head.number)
+	response.Cursor = cursorOf(logPosition{block: head.number, index: endOfBlock})
+	response.BlockHash = head.hash.Hex()
+	return response
+}
+
+// decode decodes a log selected by one of the adapter's queries.
+func (p *pool) decode(l types.Log) (*poolEvent, error) {
+	event, err := p.adapter.Decode(l)
+	if err != nil {
+		return nil, fmt.Errorf("log %v of block %v could not be decoded - %w", l.Index, l.BlockNumber, err)
+	}
+	return event, nil
+}
+
+// eventResponse builds the update after a price event, reading the state of
+// its block if the event does not carry it.
+func (p *pool) eventResponse(ctx context.Context, event *poolEvent) (*proto.Response, error) {
+	state := event.state
+	if state == nil {
+		var err error
+		state, err = p.state(ctx, new(big.Int).SetUint64(event.log.BlockNumber))
+		if err != nil {
+			return nil, err
+		}
+	}
+	blockTime, err := p.blockTime(ctx, event.log.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	response := p.priceResponse(state)
+	response.BlockTime = timestamppb.New(blockTime)
+	response.Blocknumber = int32(event.log.BlockNumber)
+	response.Cursor = cursorOf(positionOf(event.log))
+	response.BlockHash = event.log.BlockHash.Hex()
+	return response, nil
+}
+
+func (p *pool) retractionResponse(retraction *proto.Retraction) *proto.Response {
+	return &proto.Response{Token0: p.tokens[0].name, Token1: p.tokens[1].name, TimeStamp: time.Now().String(),
+		ObservedAt: timestamppb.Now(), Retraction: retraction}
+}
+
+func (p *pool) swapMessage(ctx context.Context, event *poolEvent) (*proto.Swap, error) {
+	blockTime, err := p.blockTime(ctx, event.log.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	message := &proto.Swap{
+		TimeStamp:   time.Now().String(),
+		BlockTime:   timestamppb.New(blockTime),
+		ObservedAt:  timestamppb.Now(),
+		Token0:      p.tokens[0].name,
+		Token1:      p.tokens[1].name,
+		Sender:      event.swap.sender.Hex(),
+		Recipient:   event.swap.recipient.Hex(),
+		Amount0:     scaleAmount(event.swap.amounts[0], p.tokens[0].decimals),
+		Amount1:     scaleAmount(event.swap.amounts[1], p.tokens[1].decimals),
+		TxHash:      event.log.TxHash.Hex(),
+		LogIndex:    uint32(event.log.Index),
+		Blocknumber: event.log.BlockNumber,
+		BlockHash:   event.log.BlockHash.Hex(),
+		Verified:    p.verified,
+	}
+	for i, amount := range event.swap.amounts {
+		message.Amounts = append(message.Amounts, scaleAmount(amount, p.tokens[i].decimals))
+	}
+	if state := event.state; state != nil {
+		if state.sqrtPriceX96 != nil {
+			message.SqrtPriceX96 = state.sqrtPriceX96.String()
+		}
+		if state.tick != nil {
+			message.Tick = int32(state.tick.Int64())
+		}
+		if state.liquidity != nil {
+			message.Liquidity = state.liquidity.String()
+		}
+	}
+	return message, nil
+}
+
+// scaleAmount renders a raw token amount as an exact decimal string.
+func scaleAmount(amount *big.Int, decimals uint8) string {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	return new(big.Rat).SetFrac(amount, unit).FloatString(int(decimals))
+}
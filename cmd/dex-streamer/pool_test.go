@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"testing"
+)
+
+func TestConfirmations(t *testing.T) {
+	chain := chains["ethereum"]
+	http := &providerPool{chain: chain, providers: []*provider{{url: "https://node.test"}}}
+	ws := &providerPool{chain: chain, providers: []*provider{{url: "https://node.test"}, {url: "wss://node.test"}}}
+	tests := []struct {
+		name          string
+		backend       *providerPool
+		contract      *proto.Contract
+		pushed        bool
+		confirmations uint32
+	}{
+		{"polled default", http, &proto.Contract{}, false, chain.confirmations},
+		{"pushed default", ws, &proto.Contract{}, true, 0},
+		{"polled latest", http, &proto.Contract{BlockTag: "latest"}, false, 0},
+		{"pushed latest", ws, &proto.Contract{BlockTag: "latest"}, true, 0},
+		{"explicit depth", ws, &proto.Contract{Confirmations: 5}, false, 5},
+		{"safe", ws, &proto.Contract{BlockTag: "Safe"}, false, 0},
+		{"finalized with depth", http, &proto.Contract{BlockTag: "finalized", Confirmations: 3}, false, 3},
+	}
+	for _, test := range tests {
+		r := &contractReader{backend: test.backend, chain: chain}
+		if got := r.pushed(test.contract); got != test.pushed {
+			t.Errorf("%v: pushed = %v, want %v", test.name, got, test.pushed)
+		}
+		if got := r.confirmations(test.contract); got != test.confirmations {
+			t.Errorf("%v: confirmations = %v, want %v", test.name, got, test.confirmations)
+		}
+	}
+}
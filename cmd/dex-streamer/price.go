@@ -0,0 +1,38 @@
+package main
+
+import (
+	"math/big"
+)
+
+// defaultPrecision is the number of decimal places of exact prices unless the
+// client asks for another one.
+const defaultPrecision = 18
+
+// maxPrecision bounds the decimal places a client can ask for, enough for any
+// uint256 amount.
+const maxPrecision = 78
+
+// q192 is the fixed-point denominator of sqrtPriceX96 squared.
+var q192 = new(big.Int).Lsh(big.NewInt(1), 192)
+
+// sqrtPriceRatio returns the price of token0 in token1 in raw token units,
+// (sqrtPriceX96 / 2^96)^2, as an exact fraction.
+func sqrtPriceRatio(sqrtPriceX96 *big.Int) *big.Rat {
+	numerator := new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96)
+	return new(big.Rat).SetFrac(numerator, q192)
+}
+
+// scalePrice converts a price in raw token units into whole tokens,
+// raw * 10^(baseDecimals - quoteDecimals).
+func scalePrice(raw *big.Rat, baseDecimals uint8, quoteDecimals uint8) *big.Rat {
+	price := new(big.Rat).Set(raw)
+	shift := int64(baseDecimals) - int64(quoteDecimals)
+	if shift >= 0 {
+		return price.Mul(price, new(big.Rat).SetInt(pow10(shift)))
+	}
+	return price.Quo(price, new(big.Rat).SetInt(pow10(-shift)))
+}
+
+func pow10(exponent int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(exponent), nil)
+}
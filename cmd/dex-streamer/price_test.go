@@ -0,0 +1,61 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func rat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(s)
+	}
+	return r
+}
+
+func TestSqrtPriceRatio(t *testing.T) {
+	q96 := new(big.Int).Lsh(big.NewInt(1), 96)
+	tests := []struct {
+		sqrtPriceX96 *big.Int
+		want         *big.Rat
+	}{
+		{q96, rat("1")},
+		{new(big.Int).Lsh(q96, 1), rat("4")},
+		{new(big.Int).Rsh(q96, 1), rat("1/4")},
+		{new(big.Int).Mul(q96, big.NewInt(3)), rat("9")},
+		{new(big.Int), rat("0")},
+	}
+	for _, test := range tests {
+		if got := sqrtPriceRatio(test.sqrtPriceX96); got.Cmp(test.want) != 0 {
+			t.Errorf("sqrtPriceRatio(%v) = %v, want %v", test.sqrtPriceX96, got, test.want)
+		}
+	}
+}
+
+func TestScalePrice(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           *big.Rat
+		baseDecimals  uint8
+		quoteDecimals uint8
+		want          *big.Rat
+	}{
+		// 0.0005 WETH per USDC is 5e8 wei per USDC unit.
+		{"USDC in WETH", rat("500000000"), 6, 18, rat("0.0005")},
+		// 2000 USDT per WETH is 2e-9 USDT units per wei.
+		{"WETH in USDT", rat("2/1000000000"), 18, 6, rat("2000")},
+		{"WBTC in WETH", rat("150000000000"), 8, 18, rat("15")},
+		{"same decimals", rat("1234/1000"), 18, 18, rat("1.234")},
+		{"no decimals", rat("7"), 0, 0, rat("7")},
+		{"zero", rat("0"), 18, 6, rat("0")},
+	}
+	for _, test := range tests {
+		raw := new(big.Rat).Set(test.raw)
+		if got := scalePrice(test.raw, test.baseDecimals, test.quoteDecimals); got.Cmp(test.want) != 0 {
+			t.Errorf("%v: scalePrice(%v, %v, %v) = %v, want %v", test.name, test.raw, test.baseDecimals, test.quoteDecimals, got, test.want)
+		}
+		if test.raw.Cmp(raw) != 0 {
+			t.Errorf("%v: scalePrice modified its argument", test.name)
+		}
+	}
+}
@@ -0,0 +1,552 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	minBackoff       = time.Second
+	maxBackoff       = 2 * time.Minute
+	healthTimeout    = 5 * time.Second
+	dialTimeout      = 10 * time.Second
+	maxHeadLag       = 5    // blocks a provider may trail the best one before it counts as unhealthy
+	scoreSmoothing   = 0.2  // weight of the newest sample in latency and error rate averages
+	errorRatePenalty = 10.0 // how much an error rate of 1 inflates a provider's latency score
+)
+
+var errLagging = errors.New("provider is lagging behind the chain head")
+
+// provider is one upstream endpoint with its connection and health record.
+type provider struct {
+	url     string
+	name    string // logged instead of the URL, which may carry credentials
+	chainID uint64
+
+	mu        sync.Mutex
+	rpcClient *rpc.Client
+	client    *ethclient.Client
+	latency   time.Duration
+	errorRate float64
+	failures  int
+	retryAt   time.Time
+}
+
+func newProvider(config providerConfig, chainID uint64) *provider {
+	return &provider{url: config.URL, name: config.Name, chainID: chainID}
+}
+
+// connect returns the provider's clients, dialing again after a connection was
+// dropped. A fresh connection is only used once it proved to serve the
+// expected chain. Dialing happens outside the lock so that ranking and other
+// streams are not held up by a slow endpoint.
+func (p *provider) connect(ctx context.Context) (*rpc.Client, *ethclient.Client, error) {
+	p.mu.Lock()
+	rpcClient, client := p.rpcClient, p.client
+	p.mu.Unlock()
+	if client != nil {
+		return rpcClient, client, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	rpcClient, err := rpc.DialContext(ctx, p.url)
+	if err != nil {
+		return nil, nil, p.redact(err)
+	}
+	client = ethclient.NewClient(rpcClient)
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("chain id could not be fetched - %w", p.redact(err))
+	}
+	if !chainID.IsUint64() || chainID.Uint64() != p.chainID {
+		client.Close()
+		return nil, nil, fmt.Errorf("provider serves chain id %v, expected %v", chainID, p.chainID)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.client != nil {
+		// Another stream connected meanwhile, keep its connection.
+		client.Close()
+		return p.rpcClient, p.client, nil
+	}
+	p.rpcClient, p.client = rpcClient, client
+	return rpcClient, client, nil
+}
+
+// redact replaces the provider's URL in errors of the HTTP transport, such as
+// Post "https://…/v3/<key>": dial tcp …, with its name. Errors end up in logs
+// and in the status streams end with, while URLs usually carry API keys.
+func (p *provider) redact(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = p.name
+	}
+	return err
+}
+
+func (p *provider) succeeded(latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.latency == 0 {
+		p.latency = latency
+	} else {
+		p.latency = time.Duration((1-scoreSmoothing)*float64(p.latency) + scoreSmoothing*float64(latency))
+	}
+	p.errorRate *= 1 - scoreSmoothing
+	p.failures = 0
+	p.retryAt = time.Time{}
+}
+
+// failed records an error and benches the provider with exponential backoff.
+// Other streams may hold subscriptions on the connection, so it is only
+// dropped when it broke; the next attempt then dials from scratch.
+func (p *provider) failed(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.errorRate = (1-scoreSmoothing)*p.errorRate + scoreSmoothing
+	p.failures++
+	backoff := minBackoff << (p.failures - 1)
+	if backoff > maxBackoff || backoff <= 0 {
+		backoff = maxBackoff
+	}
+	p.retryAt = time.Now().Add(backoff)
+	if p.client != nil && transportFailure(err) {
+		p.client.Close()
+		p.rpcClient, p.client = nil, nil
+	}
+	log.Printf("Provider %v failed, benched for %v - %v", p.name, backoff, err)
+}
+
+// score orders healthy providers, lower is better.
+func (p *provider) score() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return float64(p.latency) * (1 + errorRatePenalty*p.errorRate)
+}
+
+func (p *provider) benchedUntil() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.retryAt
+}
+
+// providerPool spreads the calls of a chain over several endpoints. Calls go
+// to the best scored healthy provider and fail over to the next one when a
+// provider errors, so streams survive a flaky endpoint.
+type providerPool struct {
+	chain     *chainInfo
+	providers []*provider
+	preferred *provider // the provider the client named, if any
+}
+
+// ranked returns healthy providers by score, followed by benched ones in the
+// order they become available again. The preferred provider leads unless it
+// is benched.
+func (pp *providerPool) ranked(filter func(*provider) bool) []*provider {
+	now := time.Now()
+	var healthy, benched []*provider
+	for _, p := range pp.providers {
+		if filter != nil && !filter(p) {
+			continue
+		}
+		if p.benchedUntil().After(now) {
+			benched = append(benched, p)
+		} else {
+			healthy = append(healthy, p)
+		}
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		if healthy[i] == pp.preferred || healthy[j] == pp.preferred {
+			return healthy[i] == pp.preferred
+		}
+		return healthy[i].score() < healthy[j].score()
+	})
+	sort.SliceStable(benched, func(i, j int) bool { return benched[i].benchedUntil().Before(benched[j].benchedUntil()) })
+	return append(healthy, benched...)
+}
+
+// providerFault tells whether err is the provider's fault, as opposed to a
+// call the node rightfully rejected. Log queries refused for their size fail
+// on every provider and are narrowed by the caller instead.
+func providerFault(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, ethereum.NotFound) || isRangeTooLarge(err) {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == -32005
+	}
+	return true
+}
+
+// transportFailure tells whether err means the connection itself broke, as
+// opposed to the node answering with an error or a single call timing out.
+func transportFailure(err error) bool {
+	var rpcErr rpc.Error
+	var httpErr rpc.HTTPError
+	return !errors.As(err, &rpcErr) && !errors.As(err, &httpErr) && !errors.Is(err, errLagging) &&
+		!errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled)
+}
+
+func (pp *providerPool) call(ctx context.Context, filter func(*provider) bool, call func(*rpc.Client, *ethclient.Client) error) error {
+	var lastErr error
+	for _, p := range pp.ranked(filter) {
+		rpcClient, client, err := p.connect(ctx)
+		if err == nil {
+			start := time.Now()
+			err = p.redact(call(rpcClient, client))
+			if err == nil || !providerFault(err) || ctx.Err() != nil {
+				if err == nil {
+					p.succeeded(time.Since(start))
+				}
+				return err
+			}
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		p.failed(err)
+		lastErr = err
+	}
+	if lastErr == nil {
+		return fmt.Errorf("no provider available for %v", pp.chain.name)
+	}
+	return lastErr
+}
+
+func (pp *providerPool) do(ctx context.Context, call func(*ethclient.Client) error) error {
+	return pp.call(ctx, nil, func(_ *rpc.Client, client *ethclient.Client) error {
+		return call(client)
+	})
+}
+
+// canSubscribe tells whether any provider of the pool can push events.
+func (pp *providerPool) canSubscribe() bool {
+	for _, p := range pp.providers {
+		if isWebsocket(p.url) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWebsocketProvider(p *provider) bool {
+	return isWebsocket(p.url)
+}
+
+// checkHealth probes every provider that is not benched, refreshing its score
+// and reconnecting dropped ones. Providers trailing the best head are benched.
+func (pp *providerPool) checkHealth() {
+	now := time.Now()
+	heads := make(map[*provider]uint64)
+	var best uint64
+	for _, p := range pp.providers {
+		if p.benchedUntil().After(now) {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), healthTimeout)
+		_, client, err := p.connect(ctx)
+		var head uint64
+		if err == nil {
+			start := time.Now()
+			head, err = client.BlockNumber(ctx)
+			err = p.redact(err)
+			if err == nil {
+				p.succeeded(time.Since(start))
+			}
+		}
+		cancel()
+		if err != nil {
+			p.failed(err)
+			continue
+		}
+		heads[p] = head
+		if head > best {
+			best = head
+		}
+	}
+	for p, head := range heads {
+		if head+maxHeadLag < best {
+			p.failed(errLagging)
+		}
+	}
+}
+
+func (pp *providerPool) monitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		pp.checkHealth()
+	}
+}
+
+// The methods below make the pool usable wherever a single ethclient was used
+// before, including as the backend of the generated contract bindings.
+
+func (pp *providerPool) BlockNumber(ctx context.Context) (number uint64, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		number, err = client.BlockNumber(ctx)
+		return err
+	})
+	return number, err
+}
+
+func (pp *providerPool) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		header, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+// BlockHeader fetches the header of block, a hex number or a tag such as
+// latest, safe or finalized.
+func (pp *providerPool) BlockHeader(ctx context.Context, block string) (header *blockHeader, err error) {
+	err = pp.CallContext(ctx, &header, "eth_getBlockByNumber", block, false)
+	if err == nil && header == nil {
+		err = ethereum.NotFound
+	}
+	return header, err
+}
+
+func (pp *providerPool) HeaderByHash(ctx context.Context, hash common.Hash) (header *types.Header, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		header, err = client.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+// CallContext performs a raw JSON-RPC call for what ethclient cannot express.
+func (pp *providerPool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return pp.call(ctx, nil, func(rpcClient *rpc.Client, _ *ethclient.Client) error {
+		return rpcClient.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCallContext sends several JSON-RPC calls in one request. A failed call
+// fails the batch, so that it moves on to the next provider like any call.
+func (pp *providerPool) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) error {
+	return pp.call(ctx, nil, func(rpcClient *rpc.Client, _ *ethclient.Client) error {
+		if err := rpcClient.BatchCallContext(ctx, batch); err != nil {
+			return err
+		}
+		for _, elem := range batch {
+			if elem.Error != nil {
+				return elem.Error
+			}
+		}
+		return nil
+	})
+}
+
+func (pp *providerPool) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		code, err = client.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (pp *providerPool) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		result, err = client.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (pp *providerPool) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		code, err = client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+func (pp *providerPool) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		nonce, err = client.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+func (pp *providerPool) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		price, err = client.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (pp *providerPool) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		tip, err = client.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tip, err
+}
+
+func (pp *providerPool) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		gas, err = client.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// SendTransaction is refused: a retried send could broadcast twice, and the
+// streamer only ever reads.
+func (pp *providerPool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return errors.New("dex-streamer does not send transactions")
+}
+
+func (pp *providerPool) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = pp.do(ctx, func(client *ethclient.Client) error {
+		logs, err = client.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes through the best websocket provider. When the
+// subscription drops, subscribing again fails over to the next one.
+func (pp *providerPool) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (subscription ethereum.Subscription, err error) {
+	err = pp.call(ctx, isWebsocketProvider, func(_ *rpc.Client, client *ethclient.Client) error {
+		subscription, err = client.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return subscription, err
+}
+
+// providerConfig is a named upstream endpoint from the server's provider file.
+// URLs usually carry API keys and never leave the server.
+type providerConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// loadProviders reads the provider file, a JSON object mapping chain names to
+// their providers. ${VAR} references in URLs are expanded from the environment
+// so that keys can be kept out of the file.
+func loadProviders(path string) (map[string][]providerConfig, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config map[string][]providerConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("%v is not a valid provider file - %w", path, err)
+	}
+	configured := make(map[string][]providerConfig)
+	for name, providers := range config {
+		info, ok := lookupChain(name)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a supported chain", name)
+		}
+		chain := info.name
+		names := make(map[string]bool)
+		for _, provider := range providers {
+			provider.Name = strings.ToLower(provider.Name)
+			provider.URL = os.ExpandEnv(provider.URL)
+			if provider.Name == "" || provider.URL == "" {
+				return nil, fmt.Errorf("every provider of %v needs a name and a url", chain)
+			}
+			if names[provider.Name] {
+				return nil, fmt.Errorf("provider %v is configured twice for %v", provider.Name, chain)
+			}
+			names[provider.Name] = true
+			configured[chain] = append(configured[chain], provider)
+		}
+	}
+	return configured, nil
+}
+
+// providerRegistry hands out one pool per chain and requested provider. The
+// pools of a chain share one provider per URL, so that all streams on an
+// endpoint share its connection and health record.
+type providerRegistry struct {
+	mu             sync.Mutex
+	chains         map[string][]providerConfig
+	pools          map[string]*providerPool
+	healthInterval time.Duration
+}
+
+func newProviderRegistry(chains map[string][]providerConfig, healthInterval time.Duration) *providerRegistry {
+	return &providerRegistry{chains: chains, pools: make(map[string]*providerPool), healthInterval: healthInterval}
+}
+
+// pool returns the providers for a stream. Clients only name a provider the
+// server configured, never a URL. The named provider is preferred and the
+// other providers of the chain serve as fallback; without a name all of them
+// are ranked by health alone.
+func (r *providerRegistry) pool(chain *chainInfo, name string) (*providerPool, error) {
+	name = strings.ToLower(name)
+	configured, ok := r.chains[chain.name]
+	if !ok {
+		return nil, fmt.Errorf("no provider configured for chain %q", chain.name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// The pool without a preference holds every provider of the chain and is
+	// the one health checked.
+	all, ok := r.pools[chain.name+"|"]
+	if !ok {
+		all = &providerPool{chain: chain}
+		byURL := make(map[string]bool)
+		for _, config := range configured {
+			if !byURL[config.URL] {
+				byURL[config.URL] = true
+				all.providers = append(all.providers, newProvider(config, chain.id))
+			}
+		}
+		r.pools[chain.name+"|"] = all
+		go all.monitor(r.healthInterval)
+	}
+	if name == "" {
+		return all, nil
+	}
+
+	key := chain.name + "|" + name
+	if pool, ok := r.pools[key]; ok {
+		return pool, nil
+	}
+	for _, config := range configured {
+		if config.Name != name {
+			continue
+		}
+		for _, p := range all.providers {
+			if p.url == config.URL {
+				pool := &providerPool{chain: chain, providers: all.providers, preferred: p}
+				r.pools[key] = pool
+				return pool, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown provider %q for chain %q", name, chain.name)
+}
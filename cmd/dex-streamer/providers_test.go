@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/grpc/codes"
+	"log"
+	"math/big"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testNode is the eth namespace of an in-process node. Its chain stands at
+// head with logs, and it refuses log queries spanning more than maxRange
+// blocks if set. It accepts subscriptions as long as subscriptions permits.
+// Every subscription is sent one log of the next block.
+type testNode struct {
+	head     uint64
+	logs     []types.Log
+	maxRange uint64
+
+	mu            sync.Mutex
+	subscriptions int
+	queries       [][2]uint64 // block ranges of the log queries
+}
+
+func (n *testNode) GetBlockByNumber(block string, full bool) (map[string]interface{}, error) {
+	return map[string]interface{}{"number": hexutil.EncodeUint64(n.head), "hash": common.BigToHash(new(big.Int).SetUint64(n.head)).Hex()}, nil
+}
+
+func (n *testNode) GetLogs(query struct {
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+}) ([]types.Log, error) {
+	from, to := uint64(query.FromBlock), uint64(query.ToBlock)
+	n.mu.Lock()
+	n.queries = append(n.queries, [2]uint64{from, to})
+	n.mu.Unlock()
+	if n.maxRange != 0 && to-from+1 > n.maxRange {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+	logs := []types.Log{}
+	for _, l := range n.logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (n *testNode) Logs(ctx context.Context, query interface{}) (*rpc.Subscription, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.subscriptions == 0 {
+		return nil, errors.New("subscriptions exhausted")
+	}
+	n.subscriptions--
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	subscription := notifier.CreateSubscription()
+	// Notifications are held back until the subscription is answered.
+	notifier.Notify(subscription.ID, types.Log{BlockNumber: n.head + 1, Topics: []common.Hash{}})
+	return subscription, nil
+}
+
+// serve connects a websocket provider to an in-process server of node.
+func serve(t *testing.T, node *testNode, name string) *provider {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", node); err != nil {
+		t.Fatal(err)
+	}
+	rpcClient := rpc.DialInProc(server)
+	// Redialing the URL fails at once, so dropped connections stay down.
+	p := &provider{url: "ws://127.0.0.1:0", name: name, rpcClient: rpcClient, client: ethclient.NewClient(rpcClient)}
+	t.Cleanup(func() {
+		rpcClient.Close()
+		server.Stop()
+	})
+	return p
+}
+
+func TestProviderURLRedacted(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	// Nothing listens on port 1, so every request fails in the HTTP transport.
+	p := newProvider(providerConfig{Name: "infura", URL: "http://127.0.0.1:1/v3/secret-key"}, 1)
+	pp := &providerPool{chain: chains["ethereum"], providers: []*provider{p}}
+	_, err := pp.BlockHeader(context.Background(), "latest")
+	if err == nil {
+		t.Fatal("request to a closed port succeeded")
+	}
+	for _, leaked := range []string{err.Error(), streamStatus(err).Error(), upstreamStatus(codes.NotFound, err, "pool").Error(), logged.String()} {
+		if strings.Contains(leaked, "secret-key") || !strings.Contains(leaked, "infura") {
+			t.Errorf("%q does not name the provider instead of its URL", leaked)
+		}
+	}
+}
+
+func TestRanked(t *testing.T) {
+	now := time.Now()
+	fast := &provider{name: "fast", latency: 10 * time.Millisecond}
+	slow := &provider{name: "slow", latency: 50 * time.Millisecond}
+	flaky := &provider{name: "flaky", latency: 10 * time.Millisecond, errorRate: 1}
+	benched := &provider{name: "benched", retryAt: now.Add(time.Minute)}
+	longBenched := &provider{name: "long benched", retryAt: now.Add(time.Hour)}
+	ws := &provider{name: "ws", url: "wss://node.test", latency: time.Second}
+	tests := []struct {
+		name      string
+		providers []*provider
+		preferred *provider
+		filter    func(*provider) bool
+		want      []*provider
+	}{
+		{"by score", []*provider{slow, flaky, fast}, nil, nil, []*provider{fast, slow, flaky}},
+		{"preferred first", []*provider{fast, slow}, slow, nil, []*provider{slow, fast}},
+		{"benched last", []*provider{longBenched, benched, slow}, nil, nil, []*provider{slow, benched, longBenched}},
+		{"benched preferred", []*provider{benched, fast}, benched, nil, []*provider{fast, benched}},
+		{"filtered", []*provider{fast, ws, slow}, nil, isWebsocketProvider, []*provider{ws}},
+	}
+	for _, test := range tests {
+		pp := &providerPool{providers: test.providers, preferred: test.preferred}
+		got := pp.ranked(test.filter)
+		if len(got) != len(test.want) {
+			t.Errorf("%v: ranked %v providers, want %v", test.name, len(got), len(test.want))
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%v: provider %v is %v, want %v", test.name, i, got[i].name, test.want[i].name)
+			}
+		}
+	}
+}
+
+func TestProviderBackoff(t *testing.T) {
+	p := &provider{name: "test"}
+	for failures, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second,
+		32 * time.Second, 64 * time.Second, maxBackoff, maxBackoff} {
+		before := time.Now()
+		p.failed(errLagging)
+		if got := p.retryAt.Sub(before); got < want || got > want+time.Second {
+			t.Fatalf("benched for %v after %v failures, want %v", got, failures+1, want)
+		}
+	}
+	// Far past the cap the shift overflows, which must not unbench the provider.
+	p.failures = 70
+	p.failed(errLagging)
+	if got := time.Until(p.retryAt); got < maxBackoff-time.Second {
+		t.Fatalf("benched for %v after %v failures, want %v", got, p.failures, maxBackoff)
+	}
+	p.succeeded(time.Millisecond)
+	if !p.retryAt.IsZero() || p.failures != 0 {
+		t.Fatalf("provider still benched after succeeding")
+	}
+}
+
+// testRPCError is an error a node answered with.
+type testRPCError int
+
+func (e testRPCError) Error() string  { return fmt.Sprintf("rpc error %d", int(e)) }
+func (e testRPCError) ErrorCode() int { return int(e) }
+
+func TestProviderErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		fault     bool
+		transport bool // only asked for faults
+	}{
+		{"cancelled", fmt.Errorf("call - %w", context.Canceled), false, false},
+		{"not found", ethereum.NotFound, false, false},
+		{"range too large", errors.New("query returned more than 10000 results"), false, false},
+		{"reverted", testRPCError(3), false, false},
+		{"bad request", rpc.HTTPError{StatusCode: 400}, false, false},
+		{"limit exceeded", testRPCError(-32005), true, false},
+		{"rate limited", rpc.HTTPError{StatusCode: 429}, true, false},
+		{"server error", rpc.HTTPError{StatusCode: 503}, true, false},
+		{"timeout", context.DeadlineExceeded, true, false},
+		{"lagging", errLagging, true, false},
+		{"refused", &url.Error{Op: "Post", URL: "node", Err: errors.New("connection refused")}, true, true},
+		{"closed", errors.New("client is closed"), true, true},
+	}
+	for _, test := range tests {
+		if got := providerFault(test.err); got != test.fault {
+			t.Errorf("%v: providerFault = %v, want %v", test.name, got, test.fault)
+		}
+		if got := transportFailure(test.err); test.fault && got != test.transport {
+			t.Errorf("%v: transportFailure = %v, want %v", test.name, got, test.transport)
+		}
+	}
+}
+
+func TestCallFailover(t *testing.T) {
+	reverted, refused := testRPCError(3), errors.New("connection refused")
+	tests := []struct {
+		name    string
+		errs    []error // what each provider's call fails with
+		err     error
+		called  int
+		benched []bool
+	}{
+		{"first succeeds", []error{nil, nil}, nil, 1, []bool{false, false}},
+		{"fails over", []error{refused, nil}, nil, 2, []bool{true, false}},
+		{"rejected call", []error{reverted, nil}, reverted, 1, []bool{false, false}},
+		{"every provider fails", []error{refused, testRPCError(-32005)}, testRPCError(-32005), 2, []bool{true, true}},
+	}
+	for _, test := range tests {
+		pp := &providerPool{chain: &chainInfo{name: "test"}}
+		errs := make(map[*rpc.Client]error)
+		for i, err := range test.errs {
+			p := serve(t, &testNode{}, fmt.Sprint(i))
+			pp.providers = append(pp.providers, p)
+			errs[p.rpcClient] = err
+		}
+		pp.preferred = pp.providers[0]
+		called := 0
+		err := pp.call(context.Background(), nil, func(rpcClient *rpc.Client, _ *ethclient.Client) error {
+			called++
+			return errs[rpcClient]
+		})
+		if err != test.err || called != test.called {
+			t.Errorf("%v: call failed with %v after %v calls, want %v after %v", test.name, err, called, test.err, test.called)
+		}
+		for i, p := range pp.providers {
+			if benched := !p.retryAt.IsZero(); benched != test.benched[i] {
+				t.Errorf("%v: provider %v benched %v, want %v", test.name, i, benched, test.benched[i])
+			}
+		}
+		if pp.providers[0].client != nil && test.errs[0] == refused {
+			t.Errorf("%v: broken connection kept", test.name)
+		}
+	}
+}
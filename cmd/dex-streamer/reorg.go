@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"math/big"
+)
+
+// reorgDepth is how many recent blocks a stream remembers to detect reorganisations.
+const reorgDepth = 64
+
+// headerReader is the part of a client the reorg check needs.
+type headerReader interface {
+	BlockHeader(ctx context.Context, block string) (*blockHeader, error)
+}
+
+// blockHeader is the part of a block header streams need, with the hashes the
+// node reports. go-ethereum v1.10.26 does not know the header fields added
+// since the Shanghai upgrade, so types.Header.Hash() is wrong for newer blocks.
+type blockHeader struct {
+	number     uint64
+	hash       common.Hash
+	parentHash common.Hash
+	time       uint64
+}
+
+func (h *blockHeader) UnmarshalJSON(raw []byte) error {
+	var fields struct {
+		Number     *hexutil.Uint64 `json:"number"`
+		Hash       *common.Hash    `json:"hash"`
+		ParentHash common.Hash     `json:"parentHash"`
+		Time       hexutil.Uint64  `json:"timestamp"`
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+	if fields.Number == nil || fields.Hash == nil {
+		return fmt.Errorf("block header lacks its number or hash")
+	}
+	*h = blockHeader{number: uint64(*fields.Number), hash: *fields.Hash, parentHash: fields.ParentHash, time: uint64(fields.Time)}
+	return nil
+}
+
+// Number returns the block number as the bindings and filters take it.
+func (h *blockHeader) Number() *big.Int {
+	return new(big.Int).SetUint64(h.number)
+}
+
+type blockRef struct {
+	number  uint64
+	hash    common.Hash
+	emitted bool // an update was sent from this block
+}
+
+// blockWindow remembers the hashes of the blocks a polling stream has seen.
+type blockWindow struct {
+	blocks []blockRef
+}
+
+func (w *blockWindow) add(number uint64, hash common.Hash, emitted bool) {
+	if n := len(w.blocks); n > 0 && w.blocks[n-1].number >= number {
+		if w.blocks[n-1].number == number {
+			w.blocks[n-1].emitted = w.blocks[n-1].emitted || emitted
+		}
+		return
+	}
+	w.blocks = append(w.blocks, blockRef{number: number, hash: hash, emitted: emitted})
+	if len(w.blocks) > reorgDepth {
+		w.blocks = append(w.blocks[:0:0], w.blocks[1:]...)
+	}
+}
+
+// orphaned checks the window against the chain ending in head and removes and
+// returns the blocks that are no longer canonical, oldest first. A head that
+// simply extends the newest remembered block costs no extra request.
+func (w *blockWindow) orphaned(ctx context.Context, client headerReader, head *blockHeader) ([]blockRef, error) {
+	n := len(w.blocks)
+	if n == 0 {
+		return nil, nil
+	}
+	newest := w.blocks[n-1]
+	switch {
+	case head.number < newest.number:
+		// The provider lags behind what was already seen; check again next time.
+		return nil, nil
+	case head.number == newest.number && head.hash == newest.hash:
+		return nil, nil
+	case head.number == newest.number+1 && head.parentHash == newest.hash:
+		return nil, nil
+	}
+
+	fork := n
+	for fork > 0 {
+		ref := w.blocks[fork-1]
+		canonical := head
+		if ref.number != head.number {
+			header, err := client.BlockHeader(ctx, hexutil.EncodeUint64(ref.number))
+			if err != nil {
+				return nil, fmt.Errorf("header %v could not be fetched - %w", ref.number, err)
+			}
+			canonical = header
+		}
+		if canonical.hash == ref.hash {
+			break
+		}
+		fork--
+	}
+	orphaned := append([]blockRef(nil), w.blocks[fork:]...)
+	w.blocks = w.blocks[:fork]
+	return orphaned, nil
+}
+
+// retractionOf builds the retraction for orphaned blocks, or nil if none of
+// them produced an update.
+func retractionOf(orphaned []blockRef) *proto.Retraction {
+	var retraction *proto.Retraction
+	for _, ref := range orphaned {
+		if !ref.emitted {
+			continue
+		}
+		if retraction == nil {
+			retraction = &proto.Retraction{Blocknumber: ref.number}
+		}
+		retraction.OrphanedBlockHashes = append(retraction.OrphanedBlockHashes, ref.hash.Hex())
+	}
+	return retraction
+}
+
+// removedLogRetraction builds the retraction for a log a subscription withdrew.
+func removedLogRetraction(l types.Log) *proto.Retraction {
+	return &proto.Retraction{Blocknumber: l.BlockNumber, OrphanedBlockHashes: []string{l.BlockHash.Hex()}}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"math/big"
+	"testing"
+)
+
+// testChain serves the canonical headers of a test chain by number.
+type testChain struct {
+	headers  map[uint64]*blockHeader
+	requests int
+	err      error
+}
+
+func (c *testChain) BlockHeader(ctx context.Context, block string) (*blockHeader, error) {
+	c.requests++
+	if c.err != nil {
+		return nil, c.err
+	}
+	number, err := hexutil.DecodeUint64(block)
+	if err != nil {
+		return nil, err
+	}
+	return c.headers[number], nil
+}
+
+// fork builds the headers from..to on top of parent, told apart from other
+// forks by their hashes.
+func fork(parent *blockHeader, from uint64, to uint64, name string) map[uint64]*blockHeader {
+	headers := make(map[uint64]*blockHeader)
+	for number := from; number <= to; number++ {
+		header := &blockHeader{number: number, hash: crypto.Keccak256Hash([]byte(fmt.Sprintf("%v/%v", name, number)))}
+		if parent != nil {
+			header.parentHash = parent.hash
+		}
+		headers[number] = header
+		parent = header
+	}
+	return headers
+}
+
+// merge combines the headers of a base chain with a fork replacing some of them.
+func merge(base map[uint64]*blockHeader, fork map[uint64]*blockHeader) map[uint64]*blockHeader {
+	headers := make(map[uint64]*blockHeader)
+	for number, header := range base {
+		headers[number] = header
+	}
+	for number, header := range fork {
+		headers[number] = header
+	}
+	return headers
+}
+
+func TestBlockWindowOrphaned(t *testing.T) {
+	a := fork(nil, 8, 16, "a")
+	b := merge(a, fork(a[11], 12, 14, "b"))
+	c := merge(a, fork(a[9], 10, 14, "c"))
+	tests := []struct {
+		name     string
+		chain    map[uint64]*blockHeader
+		head     uint64
+		err      error
+		orphaned []uint64
+		requests int
+		wantErr  bool
+	}{
+		{"extends newest", a, 13, nil, nil, 0, false},
+		{"same head", a, 12, nil, nil, 0, false},
+		{"lagging provider", a, 11, nil, nil, 0, false},
+		{"skipped blocks", a, 15, nil, nil, 1, false},
+		{"newest block replaced", b, 12, nil, []uint64{12}, 1, false},
+		{"fork below the head", b, 14, nil, []uint64{12}, 2, false},
+		{"every block replaced", c, 13, nil, []uint64{10, 11, 12}, 3, false},
+		{"header unavailable", b, 14, errors.New("unavailable"), nil, 1, true},
+	}
+	for _, test := range tests {
+		var window blockWindow
+		for number := uint64(10); number <= 12; number++ {
+			window.add(number, a[number].hash, true)
+		}
+		client := &testChain{headers: test.chain, err: test.err}
+		orphaned, err := window.orphaned(context.Background(), client, test.chain[test.head])
+		if (err != nil) != test.wantErr {
+			t.Errorf("%v: orphaned failed with %v", test.name, err)
+			continue
+		}
+		if client.requests != test.requests {
+			t.Errorf("%v: %v header requests, want %v", test.name, client.requests, test.requests)
+		}
+		if len(orphaned) != len(test.orphaned) {
+			t.Errorf("%v: orphaned %v, want blocks %v", test.name, orphaned, test.orphaned)
+			continue
+		}
+		for i, ref := range orphaned {
+			if ref.number != test.orphaned[i] || ref.hash != a[ref.number].hash {
+				t.Errorf("%v: orphaned %v, want blocks %v of the old chain", test.name, orphaned, test.orphaned)
+			}
+		}
+		if !test.wantErr && len(window.blocks) != 3-len(test.orphaned) {
+			t.Errorf("%v: window keeps %v blocks, want %v", test.name, len(window.blocks), 3-len(test.orphaned))
+		}
+	}
+}
+
+func TestBlockWindowAdd(t *testing.T) {
+	var window blockWindow
+	window.add(1, common.HexToHash("0x1"), false)
+	window.add(1, common.HexToHash("0x1"), true)
+	window.add(1, common.HexToHash("0x1"), false)
+	if len(window.blocks) != 1 || !window.blocks[0].emitted {
+		t.Fatalf("window holds %v, want block 1 marked emitted", window.blocks)
+	}
+	window.add(0, common.HexToHash("0x0"), true)
+	if len(window.blocks) != 1 {
+		t.Fatalf("window took an older block: %v", window.blocks)
+	}
+	for number := uint64(2); number <= reorgDepth+10; number++ {
+		window.add(number, common.BigToHash(new(big.Int).SetUint64(number)), false)
+	}
+	if len(window.blocks) != reorgDepth || window.blocks[0].number != 11 {
+		t.Fatalf("window holds %v blocks from %v, want %v from 11", len(window.blocks), window.blocks[0].number, reorgDepth)
+	}
+}
+
+func TestRetractionOf(t *testing.T) {
+	ref := func(number uint64, emitted bool) blockRef {
+		return blockRef{number: number, hash: common.BigToHash(new(big.Int).SetUint64(number)), emitted: emitted}
+	}
+	tests := []struct {
+		name        string
+		orphaned    []blockRef
+		blocknumber uint64
+		hashes      []uint64 // nil if nothing is retracted
+	}{
+		{"nothing orphaned", nil, 0, nil},
+		{"no updates", []blockRef{ref(10, false), ref(11, false)}, 0, nil},
+		{"every block", []blockRef{ref(10, true), ref(11, true)}, 10, []uint64{10, 11}},
+		{"from the first update", []blockRef{ref(10, false), ref(11, true), ref(12, false), ref(13, true)}, 11, []uint64{11, 13}},
+	}
+	for _, test := range tests {
+		retraction := retractionOf(test.orphaned)
+		if test.hashes == nil {
+			if retraction != nil {
+				t.Errorf("%v: retracted %v, want nothing", test.name, retraction)
+			}
+			continue
+		}
+		if retraction == nil || retraction.Blocknumber != test.blocknumber || len(retraction.OrphanedBlockHashes) != len(test.hashes) {
+			t.Errorf("%v: retracted %v, want blocks %v from %v", test.name, retraction, test.hashes, test.blocknumber)
+			continue
+		}
+		for i, number := range test.hashes {
+			if want := common.BigToHash(new(big.Int).SetUint64(number)).Hex(); retraction.OrphanedBlockHashes[i] != want {
+				t.Errorf("%v: retracted hash %v, want %v", test.name, retraction.OrphanedBlockHashes[i], want)
+			}
+		}
+	}
+}
+
+// shanghaiHeader is an eth_getBlockByNumber result in the post-Shanghai format,
+// carrying a withdrawalsRoot that go-ethereum v1.10.26 does not hash.
+const shanghaiHeader = `{
+	"baseFeePerGas": "0x5d21dba00",
+	"difficulty": "0x0",
+	"extraData": "0x6265617665726275696c642e6f7267",
+	"gasLimit": "0x1c9c380",
+	"gasUsed": "0xbc614e",
+	"hash": "0xe7cec81ee21288df840cf0f7864c7212cba6300901264f570d2af5daa2320e0a",
+	"logsBloom": "0x` + "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" + `",
+	"miner": "0x95222290dd7278aa3ddd389cc1e1d165cc4bafe5",
+	"mixHash": "0x4e1f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f",
+	"nonce": "0x0000000000000000",
+	"number": "0x103ee76",
+	"parentHash": "0x3c2a7a2f0a0b6f4e5f7f6a1c1a0d1e2f3a4b5c6d7e8f90a1b2c3d4e5f6a7b8c9",
+	"receiptsRoot": "0x3d1e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e",
+	"sha3Uncles": "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+	"stateRoot": "0x1b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c",
+	"timestamp": "0x64373057",
+	"transactionsRoot": "0x2c1d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d",
+	"withdrawalsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"
+}`
+
+func TestShanghaiHeader(t *testing.T) {
+	var header blockHeader
+	if err := json.Unmarshal([]byte(shanghaiHeader), &header); err != nil {
+		t.Fatal(err)
+	}
+	var fields types.Header
+	if err := json.Unmarshal([]byte(shanghaiHeader), &fields); err != nil {
+		t.Fatal(err)
+	}
+	var withdrawals struct {
+		Root common.Hash `json:"withdrawalsRoot"`
+	}
+	if err := json.Unmarshal([]byte(shanghaiHeader), &withdrawals); err != nil {
+		t.Fatal(err)
+	}
+
+	// The node hashes the withdrawals root along with the older fields.
+	encoded, err := rlp.EncodeToBytes([]interface{}{fields.ParentHash, fields.UncleHash, fields.Coinbase, fields.Root, fields.TxHash,
+		fields.ReceiptHash, fields.Bloom, fields.Difficulty, fields.Number, fields.GasLimit, fields.GasUsed, fields.Time, fields.Extra,
+		fields.MixDigest, fields.Nonce, fields.BaseFee, withdrawals.Root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := crypto.Keccak256Hash(encoded); header.hash != want {
+		t.Fatalf("header hash = %v, want %v", header.hash, want)
+	}
+	if fields.Hash() == header.hash {
+		t.Fatal("types.Header hashes post-Shanghai headers correctly, blockHeader is no longer needed")
+	}
+	if header.number != 17034870 || header.time != 1681338455 || header.parentHash != fields.ParentHash {
+		t.Fatalf("decoded %+v", header)
+	}
+
+	// A head extending the remembered block is not mistaken for a reorg.
+	var window blockWindow
+	window.add(header.number, header.hash, true)
+	child := &blockHeader{number: header.number + 1, hash: common.HexToHash("0x1"), parentHash: header.hash}
+	client := &testChain{}
+	if orphaned, err := window.orphaned(context.Background(), client, child); err != nil || len(orphaned) != 0 || client.requests != 0 {
+		t.Fatalf("orphaned = %v, %v after %v requests, want no reorg", orphaned, err, client.requests)
+	}
+}
+
+func TestBlockHeaderIncomplete(t *testing.T) {
+	hash := common.HexToHash("0x1").Hex()
+	for _, raw := range []string{`{"number": "0x1"}`, `{"hash": "` + hash + `"}`} {
+		var header blockHeader
+		if err := json.Unmarshal([]byte(raw), &header); err == nil {
+			t.Errorf("decoding %v succeeded", raw)
+		}
+	}
+}
@@ -4,137 +4,172 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-	erc20 "github.com/toamto94/dex-streamer.git/pkg/abigen/erc20"
-	uniswapV3Pair "github.com/toamto94/dex-streamer.git/pkg/abigen/uniswapV3Pair"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	dexstreamerv2 "github.com/toamto94/dex-streamer.git/pkg/proto/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"log"
-	"math"
 	"math/big"
 	"net"
+	"strings"
 	"time"
 )
 
 var (
-	tls      = flag.Bool("tls", false, "Choose between TLS and pure TCP")
-	certFile = flag.String("cert_file", "", "TLS cert file")
-	keyFile  = flag.String("key_file", "", "TLS key file")
-	port     = flag.Int("port", 50051, "Server Port")
+	tls       = flag.Bool("tls", false, "Choose between TLS and pure TCP")
+	certFile  = flag.String("cert_file", "", "TLS cert file")
+	keyFile   = flag.String("key_file", "", "TLS key file")
+	port      = flag.Int("port", 50051, "Server Port")
+	buffer    = flag.Int("subscriber_buffer", 64, "Updates buffered per subscriber before the oldest is dropped")
+	health    = flag.Duration("health_interval", 15*time.Second, "How often upstream providers are health checked")
+	providers = flag.String("providers", "providers.json", "JSON file with the named upstream providers of each chain")
+	tokenFile = flag.String("token_cache", "tokens.json", "JSON file token metadata is cached in, empty to keep it in memory")
 )
 
 type DEXStreamerServerImp struct {
 	proto.UnimplementedDEXStreamerServer
-}
-
-func computePrice(sqrtPriceX96 *big.Int, denominator *big.Int, decimals0 uint8, decimals1 uint8) float32 {
-	decimalRatio := big.NewInt(int64(math.Pow10(int(decimals1 - decimals0))))
-	sqrtPriceX96.Mul(sqrtPriceX96, sqrtPriceX96)
-	sqrtPriceX96.Div(sqrtPriceX96, denominator)
-	price := float64(sqrtPriceX96.Int64()) / float64(decimalRatio.Int64())
-	return float32(price)
+	hub       *hub
+	providers *providerRegistry
+	tokens    *tokenCache
 }
 
 func (server *DEXStreamerServerImp) StreamContract(contract *proto.Contract, stream proto.DEXStreamer_StreamContractServer) error {
-	client, err := ethclient.Dial(contract.Endpoint)
-	if err != nil {
-		log.Fatalf("EVM endpoint could not be stablished - %v", err)
-	} else {
-		log.Printf("Connection to EVM endpoint established")
+	if _, replay := startPosition(contract); replay {
+		return server.streamHistory(contract, stream)
 	}
 
-	address := common.HexToAddress(contract.Address)
-	done := make(chan bool)
-	ticker := time.NewTicker(time.Millisecond * time.Duration(contract.ScrapeInterval))
-	defer ticker.Stop()
-
-	blocknumber, err := client.BlockNumber(context.TODO())
-	if err != nil {
-		log.Fatalf("Blocknumber could not be fetched - %v", err)
-	} else {
-		log.Printf("Current block number: %v", blocknumber)
-	}
-
-	pairInstance, err := uniswapV3Pair.NewUniswapV3PairAbigen(address, client)
-	if err != nil {
-		log.Fatalf("Pair instance could not be fetched - %v", err)
-	}
-
-	callOpts := bind.CallOpts{
-		Pending:     false,
-		BlockNumber: big.NewInt(int64(blocknumber)),
-		Context:     context.Background(),
-	}
+	subscriber := server.hub.subscribe(contract)
+	defer server.hub.unsubscribe(subscriber)
 
-	token0, err := pairInstance.Token0(&callOpts)
-	if err != nil {
-		log.Fatalf("Token0 instance could not be fetched - %v", err)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case response := <-subscriber.updates:
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		case <-subscriber.done:
+			return streamStatus(subscriber.err)
+		}
 	}
+}
 
-	token1, err := pairInstance.Token1(&callOpts)
+// readPrices is the upstream reader behind every StreamContract feed.
+func (server *DEXStreamerServerImp) readPrices(ctx context.Context, contract *proto.Contract, publish func(*proto.Response)) error {
+	p, err := server.dialPool(ctx, contract)
 	if err != nil {
-		log.Fatalf("Token1 instance could not be fetched - %v", err)
+		return err
 	}
 
-	token0Instance, err := erc20.NewErc20Abigen(token0, client)
-	token1Instance, err := erc20.NewErc20Abigen(token1, client)
-
-	token0Name, err := token0Instance.Name(&callOpts)
-	token1Name, err := token1Instance.Name(&callOpts)
-
-	decimals0, err := token0Instance.Decimals(&callOpts)
-	if err != nil {
-		log.Fatalf("Token0 decimals could not be fetched - %v", err)
-	}
+	var currentPrice *big.Rat
+	var window blockWindow
+	var retracted common.Hash
 
-	decimals1, err := token1Instance.Decimals(&callOpts)
+	// Websocket endpoints push every price event, HTTP-only endpoints fall back to polling the pool state.
+	events := make(chan types.Log)
+	var subscriptionErr <-chan error
+	var ticks <-chan time.Time
+	subscription, err := p.subscribe(ctx, contract, p.adapter.PriceEvents(), events)
 	if err != nil {
-		log.Fatalf("Token1 decimals could not be fetched - %v", err)
+		return err
 	}
-
-	denominator := big.NewInt(1)
-	x := big.NewInt(2)
-	for i := 0; i < 192; i++ {
-		denominator.Mul(denominator, x)
+	if subscription != nil {
+		defer func() { subscription.Unsubscribe() }()
+		subscriptionErr = subscription.Err()
+		// Events only arrive once the price moves, so subscribers start from the
+		// state at the head the subscription was opened at.
+		head, state, err := p.poll(ctx, contract)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		publish(p.headResponse(head, state))
+	} else {
+		ticker := time.NewTicker(p.scrapeInterval(contract))
+		defer ticker.Stop()
+		ticks = ticker.C
+		log.Printf("Polling the state of %v every %v", p.address, p.scrapeInterval(contract))
 	}
 
-	var currentSpotPrice float32
-	currentSpotPrice = -1
-
 	for {
 		select {
-		case <-done:
-			fmt.Println("Done")
+		case <-ctx.Done():
 			return nil
-		case <-ticker.C:
-			blocknumber, _ := client.BlockNumber(context.TODO())
-			callOpts := bind.CallOpts{
-				Pending:     false,
-				BlockNumber: big.NewInt(int64(blocknumber)),
-				Context:     context.Background(),
+		case err := <-subscriptionErr:
+			// Subscribing again fails over to the next websocket provider.
+			log.Printf("Log subscription dropped, resubscribing - %v", err)
+			subscription, err = p.resubscribe(ctx, contract, p.adapter.PriceEvents(), events, subscription)
+			if err != nil {
+				return err
+			}
+			subscriptionErr = subscription.Err()
+		case l := <-events:
+			if l.Removed {
+				if l.BlockHash != retracted {
+					retracted = l.BlockHash
+					publish(p.retractionResponse(removedLogRetraction(l)))
+				}
+				continue
+			}
+			event, err := p.decode(l)
+			if err != nil {
+				log.Printf("%v", err)
+				continue
+			}
+			response, err := p.eventResponse(ctx, event)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			publish(response)
+		case <-ticks:
+			head, state, err := p.poll(ctx, contract)
+			if ctx.Err() != nil {
+				return nil
 			}
-			slot0, err := pairInstance.Slot0(&callOpts)
+			if head == nil {
+				log.Printf("%v", err)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			orphaned, err := window.orphaned(ctx, p.backend, head)
 			if err != nil {
-				log.Fatalf("slot() could not be fetched - %v", err)
+				log.Printf("Reorg check failed - %v", err)
+				continue
+			}
+			if retraction := retractionOf(orphaned); retraction != nil {
+				log.Printf("Reorg below block %v, retracting updates of %v blocks", retraction.Blocknumber, len(retraction.OrphanedBlockHashes))
+				publish(p.retractionResponse(retraction))
+				// Re-emit the canonical price even if it did not change.
+				currentPrice = nil
 			}
-			sqrtPriceX96 := slot0.SqrtPriceX96
-			spotPrice := computePrice(sqrtPriceX96, denominator, decimals0, decimals1)
-			timeStamp := time.Now().String()
 
-			if spotPrice != currentSpotPrice {
-				currentSpotPrice = spotPrice
-				response := proto.Response{Token0: token0Name, Token1: token1Name, SpotPrice: spotPrice,
-					Blocknumber: int32(blocknumber), TimeStamp: timeStamp}
-				stream.Send(&response)
+			blocknumber := head.number
+			price := p.adapter.Price(state, p.base, p.quote)
+			changed := currentPrice == nil || price.Cmp(currentPrice) != 0
+			window.add(blocknumber, head.hash, changed)
+			if changed {
+				currentPrice = price
+				publish(p.headResponse(head, state))
 			}
 
 		}
 	}
 }
 
+func isWebsocket(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "ws://") || strings.HasPrefix(endpoint, "wss://")
+}
+
 func main() {
 	flag.Parse()
 	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", *port))
@@ -143,6 +178,20 @@ func main() {
 	} else {
 		log.Printf("Listening to localhost:%d", *port)
 	}
+	if *buffer < 1 {
+		log.Fatalf("subscriber_buffer must be at least 1")
+	}
+	if *health <= 0 {
+		log.Fatalf("health_interval must be positive")
+	}
+	chains, err := loadProviders(*providers)
+	if err != nil {
+		log.Fatalf("Failed to load providers - %v", err)
+	}
+	tokens, err := newTokenCache(*tokenFile)
+	if err != nil {
+		log.Fatalf("Failed to load token cache - %v", err)
+	}
 	var opts []grpc.ServerOption
 	if *tls {
 		if *certFile == "" {
@@ -158,7 +207,10 @@ func main() {
 		opts = []grpc.ServerOption{grpc.Creds(creds)}
 	}
 	grpcServer := grpc.NewServer(opts...)
-	proto.RegisterDEXStreamerServer(grpcServer, &DEXStreamerServerImp{})
+	server := &DEXStreamerServerImp{providers: newProviderRegistry(chains, *health), tokens: tokens}
+	server.hub = newHub(server.readPrices, *buffer)
+	proto.RegisterDEXStreamerServer(grpcServer, server)
+	dexstreamerv2.RegisterDEXStreamerServer(grpcServer, &DEXStreamerV2ServerImp{v1: server})
 	err = grpcServer.Serve(lis)
 	if err != nil {
 		log.Fatalf("Failed to start server - %v", err)
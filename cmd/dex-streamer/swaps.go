@@ -0,0 +1,43 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func (server *DEXStreamerServerImp) StreamSwaps(contract *proto.Contract, stream proto.DEXStreamer_StreamSwapsServer) error {
+	ctx := stream.Context()
+	p, err := server.dialPool(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	from, replay := startPosition(contract)
+	if !replay {
+		head, err := p.head(ctx, contract)
+		if err != nil {
+			return streamStatus(err)
+		}
+		from = logPosition{block: head.number + 1}
+	}
+
+	return streamStatus(p.followEvents(ctx, contract, p.adapter.SwapEvents(), from, func(l types.Log) error {
+		event, err := p.decode(l)
+		if err != nil {
+			return err
+		}
+		if event.swap == nil {
+			return nil
+		}
+		message, err := p.swapMessage(ctx, event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(message)
+	}, func(retraction *proto.Retraction) error {
+		return stream.Send(&proto.Swap{TimeStamp: time.Now().String(), ObservedAt: timestamppb.Now(), Token0: p.tokens[0].name, Token1: p.tokens[1].name,
+			Retraction: retraction})
+	}))
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	erc20Bytes32 "github.com/toamto94/dex-streamer.git/pkg/abigen/erc20Bytes32"
+	erc20Permit "github.com/toamto94/dex-streamer.git/pkg/abigen/erc20Permit"
+	erc20Standard "github.com/toamto94/dex-streamer.git/pkg/abigen/erc20Standard"
+	ownable "github.com/toamto94/dex-streamer.git/pkg/abigen/ownable"
+	pausable "github.com/toamto94/dex-streamer.git/pkg/abigen/pausable"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Interfaces a token can be probed for.
+const (
+	erc20Interface    = "erc20"
+	permitInterface   = "eip2612"
+	ownableInterface  = "ownable"
+	pausableInterface = "pausable"
+)
+
+// tokenCache keeps the metadata of tokens, which does not change once they
+// are deployed, in memory and, if it has a path, on disk.
+type tokenCache struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]tokenRecord // keyed by tokenKey
+}
+
+// tokenRecord is the cached metadata of a token.
+type tokenRecord struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+	// Interfaces is nil for records cached before tokens were probed.
+	Interfaces []string `json:"interfaces"`
+}
+
+func tokenKey(chain *chainInfo, address common.Address) string {
+	return chain.name + ":" + strings.ToLower(address.Hex())
+}
+
+// newTokenCache loads the cache stored at path. A missing file starts an
+// empty cache, an empty path one that is never written.
+func newTokenCache(path string) (*tokenCache, error) {
+	c := &tokenCache{path: path, entries: make(map[string]tokenRecord)}
+	if path == "" {
+		return c, nil
+	}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &c.entries); err != nil {
+		return nil, fmt.Errorf("%v is not a valid token cache - %w", path, err)
+	}
+	return c, nil
+}
+
+// lookup returns the metadata of tokens, reading those it misses from the
+// chain in a single multicall.
+func (c *tokenCache) lookup(opts *bind.CallOpts, chain *chainInfo, backend bind.ContractCaller, addresses ...common.Address) ([]token, error) {
+	records := make([]tokenRecord, len(addresses))
+	batch := &multicall{}
+	assemble := make(map[int]func() (tokenRecord, error))
+	c.mu.Lock()
+	for i, address := range addresses {
+		record, ok := c.entries[tokenKey(chain, address)]
+		if ok && record.Interfaces != nil {
+			records[i] = record
+		} else {
+			assemble[i] = queueToken(batch, address)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(assemble) > 0 {
+		err := batch.run(opts, backend)
+		if errors.Is(err, bind.ErrNoCode) && opts.BlockNumber != nil {
+			// Multicall3 is younger than the block, but token metadata does
+			// not change, so read it at the head instead.
+			latest := *opts
+			latest.BlockNumber = nil
+			err = batch.run(&latest, backend)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, read := range assemble {
+			record, err := read()
+			if err != nil {
+				return nil, fmt.Errorf("token %v could not be read - %w", addresses[i], err)
+			}
+			records[i] = record
+		}
+		c.mu.Lock()
+		for i := range assemble {
+			c.entries[tokenKey(chain, addresses[i])] = records[i]
+		}
+		if err := c.save(); err != nil {
+			log.Printf("Token cache could not be written - %v", err)
+		}
+		c.mu.Unlock()
+	}
+
+	tokens := make([]token, len(addresses))
+	for i, record := range records {
+		tokens[i] = token{address: addresses[i], name: record.Name, symbol: record.Symbol, decimals: record.Decimals,
+			interfaces: record.Interfaces}
+	}
+	return tokens, nil
+}
+
+// save writes the cache through a temporary file, so a crash cannot leave it
+// truncated. Callers hold mu.
+func (c *tokenCache) save() error {
+	if c.path == "" {
+		return nil
+	}
+	raw, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	temporary := c.path + ".tmp"
+	if err := os.WriteFile(temporary, raw, 0644); err != nil {
+		return err
+	}
+	return os.Rename(temporary, c.path)
+}
+
+// queueToken queues the reads of the metadata of a token and of a view
+// function of every interface it is probed for. Name and symbol are optional
+// in ERC-20, and tokens like MKR return them as bytes32.
+func queueToken(batch *multicall, address common.Address) func() (tokenRecord, error) {
+	decimals := batch.add(address, erc20Standard.Erc20StandardAbigenMetaData, "decimals")
+	name := queueText(batch, address, "name")
+	symbol := queueText(batch, address, "symbol")
+
+	probes := []struct {
+		name  string
+		calls []*batchedCall
+	}{
+		{erc20Interface, []*batchedCall{
+			batch.add(address, erc20Standard.Erc20StandardAbigenMetaData, "totalSupply"),
+			batch.add(address, erc20Standard.Erc20StandardAbigenMetaData, "allowance", common.Address{}, common.Address{}),
+		}},
+		{permitInterface, []*batchedCall{
+			batch.add(address, erc20Permit.Erc20PermitAbigenMetaData, "DOMAIN_SEPARATOR"),
+			batch.add(address, erc20Permit.Erc20PermitAbigenMetaData, "nonces", common.Address{}),
+		}},
+		{ownableInterface, []*batchedCall{batch.add(address, ownable.OwnableAbigenMetaData, "owner")}},
+		{pausableInterface, []*batchedCall{batch.add(address, pausable.PausableAbigenMetaData, "paused")}},
+	}
+
+	return func() (tokenRecord, error) {
+		if decimals.err != nil {
+			return tokenRecord{}, fmt.Errorf("decimals could not be fetched - %w", decimals.err)
+		}
+		record := tokenRecord{Name: name(), Symbol: symbol(), Decimals: *abi.ConvertType(decimals.out[0], new(uint8)).(*uint8),
+			Interfaces: []string{}}
+		for _, probe := range probes {
+			supported := true
+			for _, call := range probe.calls {
+				supported = supported && call.err == nil
+			}
+			if supported {
+				record.Interfaces = append(record.Interfaces, probe.name)
+			}
+		}
+		return record, nil
+	}
+}
+
+// queueText queues the read of an optional text field of a token in both its
+// string and bytes32 form. A token that has neither reads as empty.
+func queueText(batch *multicall, address common.Address, method string) func() string {
+	text := batch.add(address, erc20Standard.Erc20StandardAbigenMetaData, method)
+	raw := batch.add(address, erc20Bytes32.Erc20Bytes32AbigenMetaData, method)
+	return func() string {
+		if text.err == nil {
+			return *abi.ConvertType(text.out[0], new(string)).(*string)
+		}
+		if raw.err == nil {
+			bytes32 := *abi.ConvertType(raw.out[0], new([32]byte)).(*[32]byte)
+			return strings.ToValidUTF8(strings.TrimRight(string(bytes32[:]), "\x00"), "")
+		}
+		return ""
+	}
+}
+
+// GetToken returns the metadata of a token.
+func (server *DEXStreamerServerImp) GetToken(ctx context.Context, query *proto.TokenQuery) (*proto.Token, error) {
+	client, chain, err := server.dialChain(query.Chain, query.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	address, ok := chain.token(query.Token)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown token %q on %v", query.Token, chain.name)
+	}
+	tokens, err := server.tokens.lookup(&bind.CallOpts{Context: ctx}, chain, client, address)
+	if err != nil {
+		return nil, upstreamStatus(codes.FailedPrecondition, err, "token could not be read, is it an ERC-20 token?")
+	}
+	t := tokens[0]
+	return &proto.Token{Chain: chain.name, Address: t.address.Hex(), Name: t.name, Symbol: t.symbol, Decimals: uint32(t.decimals),
+		Interfaces: t.interfaces}, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	uniswapV2Pair "github.com/toamto94/dex-streamer.git/pkg/abigen/uniswapV2Pair"
+	"math/big"
+)
+
+// uniswapV2Adapter reads Uniswap V2 pairs and forks sharing their interface,
+// such as SushiSwap and PancakeSwap V2. Prices follow from the reserves, which
+// every Sync event reports after a trade or liquidity change.
+type uniswapV2Adapter struct {
+	address      common.Address
+	pairInstance *uniswapV2Pair.UniswapV2PairAbigen
+}
+
+var (
+	uniswapV2SyncTopic = eventTopic(uniswapV2Pair.UniswapV2PairAbigenMetaData, "Sync")
+	uniswapV2SwapTopic = eventTopic(uniswapV2Pair.UniswapV2PairAbigenMetaData, "Swap")
+)
+
+func newUniswapV2Adapter(_ *bind.CallOpts, address common.Address, backend bind.ContractBackend) (DEXAdapter, error) {
+	pairInstance, err := uniswapV2Pair.NewUniswapV2PairAbigen(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &uniswapV2Adapter{address: address, pairInstance: pairInstance}, nil
+}
+
+func (a *uniswapV2Adapter) Tokens(opts *bind.CallOpts) ([]common.Address, error) {
+	token0, err := a.pairInstance.Token0(opts)
+	if err != nil {
+		return nil, err
+	}
+	token1, err := a.pairInstance.Token1(opts)
+	if err != nil {
+		return nil, err
+	}
+	return []common.Address{token0, token1}, nil
+}
+
+func (a *uniswapV2Adapter) State(opts *bind.CallOpts) (*poolState, error) {
+	reserves, err := a.pairInstance.GetReserves(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &poolState{reserves: []*big.Int{reserves.Reserve0, reserves.Reserve1}}, nil
+}
+
+func (a *uniswapV2Adapter) QueueState(batch *multicall) func() (*poolState, error) {
+	reserves := batch.add(a.address, uniswapV2Pair.UniswapV2PairAbigenMetaData, "getReserves")
+	return func() (*poolState, error) {
+		if reserves.err != nil {
+			return nil, reserves.err
+		}
+		return &poolState{reserves: []*big.Int{
+			*abi.ConvertType(reserves.out[0], new(*big.Int)).(**big.Int),
+			*abi.ConvertType(reserves.out[1], new(*big.Int)).(**big.Int),
+		}}, nil
+	}
+}
+
+func (a *uniswapV2Adapter) PriceEvents() ethereum.FilterQuery {
+	return ethereum.FilterQuery{Addresses: []common.Address{a.address}, Topics: [][]common.Hash{{uniswapV2SyncTopic}}}
+}
+
+func (a *uniswapV2Adapter) SwapEvents() ethereum.FilterQuery {
+	return ethereum.FilterQuery{Addresses: []common.Address{a.address}, Topics: [][]common.Hash{{uniswapV2SwapTopic}}}
+}
+
+func (a *uniswapV2Adapter) Decode(l types.Log) (*poolEvent, error) {
+	if len(l.Topics) == 0 {
+		return nil, errors.New("anonymous log")
+	}
+	switch l.Topics[0] {
+	case uniswapV2SyncTopic:
+		sync, err := a.pairInstance.ParseSync(l)
+		if err != nil {
+			return nil, err
+		}
+		return &poolEvent{log: l, state: &poolState{reserves: []*big.Int{sync.Reserve0, sync.Reserve1}}}, nil
+	case uniswapV2SwapTopic:
+		swap, err := a.pairInstance.ParseSwap(l)
+		if err != nil {
+			return nil, err
+		}
+		amount0 := new(big.Int).Sub(swap.Amount0In, swap.Amount0Out)
+		amount1 := new(big.Int).Sub(swap.Amount1In, swap.Amount1Out)
+		return &poolEvent{log: l, swap: &swapEvent{sender: swap.Sender, recipient: swap.To, amounts: []*big.Int{amount0, amount1}}}, nil
+	}
+	return nil, fmt.Errorf("unexpected event %v", l.Topics[0])
+}
+
+// Price is the ratio of the reserves, the price of an infinitesimal trade
+// before fees.
+func (a *uniswapV2Adapter) Price(state *poolState, base int, quote int) *big.Rat {
+	if state.reserves[base].Sign() == 0 {
+		return new(big.Rat)
+	}
+	return new(big.Rat).SetFrac(state.reserves[quote], state.reserves[base])
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestUniswapV2Price(t *testing.T) {
+	tests := []struct {
+		name          string
+		reserves      []*big.Int
+		base, quote   int
+		baseDecimals  uint8
+		quoteDecimals uint8
+		want          *big.Rat
+	}{
+		{"WETH in USDC", []*big.Int{raw(2000000, 6), raw(1000, 18)}, 1, 0, 18, 6, rat("2000")},
+		{"USDC in WETH", []*big.Int{raw(2000000, 6), raw(1000, 18)}, 0, 1, 6, 18, rat("0.0005")},
+		{"exact ratio", []*big.Int{big.NewInt(3), big.NewInt(7)}, 0, 1, 0, 0, rat("7/3")},
+		{"empty base", []*big.Int{new(big.Int), raw(1000, 18)}, 0, 1, 18, 18, rat("0")},
+		{"empty quote", []*big.Int{raw(1000, 18), new(big.Int)}, 0, 1, 18, 18, rat("0")},
+	}
+	a := &uniswapV2Adapter{}
+	for _, test := range tests {
+		got := scalePrice(a.Price(&poolState{reserves: test.reserves}, test.base, test.quote), test.baseDecimals, test.quoteDecimals)
+		if got.Cmp(test.want) != 0 {
+			t.Errorf("%v: price %v, want %v", test.name, got.FloatString(18), test.want.FloatString(18))
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	algebraPool "github.com/toamto94/dex-streamer.git/pkg/abigen/algebraPool"
+	pancakeswapV3Pool "github.com/toamto94/dex-streamer.git/pkg/abigen/pancakeswapV3Pool"
+	uniswapV3Pair "github.com/toamto94/dex-streamer.git/pkg/abigen/uniswapV3Pair"
+	"log"
+	"math/big"
+)
+
+// Concentrated liquidity variants told apart by probing the pool.
+const (
+	uniswapV3Variant     = "Uniswap V3"
+	pancakeswapV3Variant = "PancakeSwap V3" // slot0().feeProtocol is a uint32, Swap logs protocol fees
+	algebraVariant       = "Algebra"        // slot0() is called globalState()
+)
+
+// variantDEX is the dex each variant is detected as, the key of its factory in
+// chainInfo.factories.
+var variantDEX = map[string]string{
+	uniswapV3Variant:     "uniswapv3",
+	pancakeswapV3Variant: "pancakeswapv3",
+	algebraVariant:       "algebra",
+}
+
+// uniswapV3PoolInitCodeHash is the hash of the code Uniswap V3 factories deploy pools with.
+var uniswapV3PoolInitCodeHash = common.HexToHash("0xe34f199b19b2b4f47f68442619d555527d244f78a3297ea89325f843f87b8b54")
+
+// PancakeSwap V3 factories leave deploying pools to a separate contract, at the
+// same address on every chain.
+var (
+	pancakeswapV3PoolDeployer     = common.HexToAddress("0x41ff9AA7e16B8B1a8a8dc4f0eFacd93D02d071c9")
+	pancakeswapV3PoolInitCodeHash = common.HexToHash("0x6ce8eb472fa82df5469c6ab6d485f17c3ad13c8cd7af59b3d4a8026c5ce0f7e2")
+)
+
+var (
+	uniswapV3SwapTopic     = eventTopic(uniswapV3Pair.UniswapV3PairAbigenMetaData, "Swap")
+	pancakeswapV3SwapTopic = eventTopic(pancakeswapV3Pool.PancakeswapV3PoolAbigenMetaData, "Swap")
+)
+
+// uniswapV3Adapter reads Uniswap V3 pools and the forks that keep its price
+// representation, priced from the current sqrtPriceX96 and Swap events.
+type uniswapV3Adapter struct {
+	address         common.Address
+	variant         string
+	swapTopic       common.Hash
+	pairInstance    *uniswapV3Pair.UniswapV3PairAbigen
+	pancakeInstance *pancakeswapV3Pool.PancakeswapV3PoolAbigen
+	algebraInstance *algebraPool.AlgebraPoolAbigen
+
+	// Read while binding the pool, nil until then.
+	tokens  []common.Address
+	factory *common.Address
+	fee     *big.Int
+}
+
+func bindUniswapV3Adapter(address common.Address, backend bind.ContractBackend) (*uniswapV3Adapter, error) {
+	pairInstance, err := uniswapV3Pair.NewUniswapV3PairAbigen(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	pancakeInstance, err := pancakeswapV3Pool.NewPancakeswapV3PoolAbigen(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	algebraInstance, err := algebraPool.NewAlgebraPoolAbigen(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &uniswapV3Adapter{address: address, variant: uniswapV3Variant, swapTopic: uniswapV3SwapTopic,
+		pairInstance: pairInstance, pancakeInstance: pancakeInstance, algebraInstance: algebraInstance}, nil
+}
+
+// newUniswapV3Adapter binds the pool and probes which variant it is: only
+// PancakeSwap V3 pools have lmPool() and only Algebra pools globalState().
+func newUniswapV3Adapter(opts *bind.CallOpts, address common.Address, backend bind.ContractBackend) (DEXAdapter, error) {
+	a, err := bindUniswapV3Adapter(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.pancakeInstance.LmPool(opts); err == nil {
+		a.variant, a.swapTopic = pancakeswapV3Variant, pancakeswapV3SwapTopic
+	} else if !reverted(err) {
+		return nil, err
+	} else if _, err := a.algebraInstance.GlobalState(opts); err == nil {
+		// Algebra's Swap event has the same signature as Uniswap V3's.
+		a.variant = algebraVariant
+	} else if !reverted(err) {
+		return nil, err
+	}
+	log.Printf("Pool %v is a %v pool", address, a.variant)
+	return a, nil
+}
+
+// queueUniswapV3Adapter is newUniswapV3Adapter for a multicall. Along with the
+// probes it queues the reads of the tokens, factory and fee, which the
+// adapter keeps for Tokens and Deployment.
+func queueUniswapV3Adapter(batch *multicall, address common.Address, backend bind.ContractBackend) func() (DEXAdapter, error) {
+	a, err := bindUniswapV3Adapter(address, backend)
+	if err != nil {
+		return func() (DEXAdapter, error) { return nil, err }
+	}
+	lmPool := batch.add(address, pancakeswapV3Pool.PancakeswapV3PoolAbigenMetaData, "lmPool")
+	globalState := batch.add(address, algebraPool.AlgebraPoolAbigenMetaData, "globalState")
+	token0 := batch.add(address, uniswapV3Pair.UniswapV3PairAbigenMetaData, "token0")
+	token1 := batch.add(address, uniswapV3Pair.UniswapV3PairAbigenMetaData, "token1")
+	factory := batch.add(address, uniswapV3Pair.UniswapV3PairAbigenMetaData, "factory")
+	fee := batch.add(address, uniswapV3Pair.UniswapV3PairAbigenMetaData, "fee")
+	return func() (DEXAdapter, error) {
+		if lmPool.err == nil {
+			a.variant, a.swapTopic = pancakeswapV3Variant, pancakeswapV3SwapTopic
+		} else if globalState.err == nil {
+			a.variant = algebraVariant
+		}
+		// Tokens reads them again if they failed, reporting why.
+		if token0.err == nil && token1.err == nil {
+			a.tokens = []common.Address{
+				*abi.ConvertType(token0.out[0], new(common.Address)).(*common.Address),
+				*abi.ConvertType(token1.out[0], new(common.Address)).(*common.Address),
+			}
+		}
+		// Algebra pools have no fixed fee, Deployment does not need it for them.
+		if factory.err == nil && fee.err == nil {
+			a.factory = abi.ConvertType(factory.out[0], new(common.Address)).(*common.Address)
+			a.fee = *abi.ConvertType(fee.out[0], new(*big.Int)).(**big.Int)
+		}
+		log.Printf("Pool %v is a %v pool", address, a.variant)
+		return a, nil
+	}
+}
+
+func (a *uniswapV3Adapter) Tokens(opts *bind.CallOpts) ([]common.Address, error) {
+	if a.tokens != nil {
+		return a.tokens, nil
+	}
+	token0, err := a.pairInstance.Token0(opts)
+	if err != nil {
+		return nil, err
+	}
+	token1, err := a.pairInstance.Token1(opts)
+	if err != nil {
+		return nil, err
+	}
+	a.tokens = []common.Address{token0, token1}
+	return a.tokens, nil
+}
+
+func (a *uniswapV3Adapter) State(opts *bind.CallOpts) (*poolState, error) {
+	switch a.variant {
+	case pancakeswapV3Variant:
+		slot0, err := a.pancakeInstance.Slot0(opts)
+		if err != nil {
+			return nil, fmt.Errorf("slot0() could not be fetched - %w", err)
+		}
+		return &poolState{sqrtPriceX96: slot0.SqrtPriceX96, tick: slot0.Tick}, nil
+	case algebraVariant:
+		globalState, err := a.algebraInstance.GlobalState(opts)
+		if err != nil {
+			return nil, fmt.Errorf("globalState() could not be fetched - %w", err)
+		}
+		return &poolState{sqrtPriceX96: globalState.Price, tick: globalState.Tick}, nil
+	}
+	slot0, err := a.pairInstance.Slot0(opts)
+	if err != nil {
+		return nil, fmt.Errorf("slot0() could not be fetched - %w", err)
+	}
+	return &poolState{sqrtPriceX96: slot0.SqrtPriceX96, tick: slot0.Tick}, nil
+}
+
+// QueueState queues the read State makes. slot0() and globalState() both
+// start with the price and the tick.
+func (a *uniswapV3Adapter) QueueState(batch *multicall) func() (*poolState, error) {
+	metadata, method := uniswapV3Pair.UniswapV3PairAbigenMetaData, "slot0"
+	switch a.variant {
+	case pancakeswapV3Variant:
+		metadata = pancakeswapV3Pool.PancakeswapV3PoolAbigenMetaData
+	case algebraVariant:
+		metadata, method = algebraPool.AlgebraPoolAbigenMetaData, "globalState"
+	}
+	slot0 := batch.add(a.address, metadata, method)
+	return func() (*poolState, error) {
+		if slot0.err != nil {
+			return nil, fmt.Errorf("%v() could not be fetched - %w", method, slot0.err)
+		}
+		return &poolState{
+			sqrtPriceX96: *abi.ConvertType(slot0.out[0], new(*big.Int)).(**big.Int),
+			tick:         *abi.ConvertType(slot0.out[1], new(*big.Int)).(**big.Int),
+		}, nil
+	}
+}
+
+// Deployment recomputes the CREATE2 address of Uniswap V3 and PancakeSwap V3
+// pools from the factory they name, their tokens and fee, reusing what binding
+// the pool read. Algebra forks each deploy differently, so only their variant
+// is reported.
+func (a *uniswapV3Adapter) Deployment(opts *bind.CallOpts) (*deployment, error) {
+	if a.variant == algebraVariant {
+		return &deployment{dex: variantDEX[a.variant]}, nil
+	}
+	if a.factory == nil || a.fee == nil {
+		factory, err := a.pairInstance.Factory(opts)
+		if err != nil {
+			return nil, fmt.Errorf("factory could not be fetched - %w", err)
+		}
+		fee, err := a.pairInstance.Fee(opts)
+		if err != nil {
+			return nil, fmt.Errorf("fee could not be fetched - %w", err)
+		}
+		a.factory, a.fee = &factory, fee
+	}
+	tokens, err := a.Tokens(opts)
+	if err != nil {
+		return nil, err
+	}
+	factory, fee := *a.factory, a.fee
+	address := uniswapV3PoolAddress(factory, tokens[0], tokens[1], fee)
+	if a.variant == pancakeswapV3Variant {
+		address = pancakeswapV3PoolAddress(tokens[0], tokens[1], fee)
+	}
+	return &deployment{dex: variantDEX[a.variant], factory: factory, address: address}, nil
+}
+
+// uniswapV3PoolAddress is the address factory deploys the pool of token0, token1 and fee to.
+func uniswapV3PoolAddress(factory common.Address, token0 common.Address, token1 common.Address, fee *big.Int) common.Address {
+	return crypto.CreateAddress2(factory, poolSalt(token0, token1, fee), uniswapV3PoolInitCodeHash.Bytes())
+}
+
+// pancakeswapV3PoolAddress is the address the PancakeSwap V3 pool of token0, token1 and fee is deployed to.
+func pancakeswapV3PoolAddress(token0 common.Address, token1 common.Address, fee *big.Int) common.Address {
+	return crypto.CreateAddress2(pancakeswapV3PoolDeployer, poolSalt(token0, token1, fee), pancakeswapV3PoolInitCodeHash.Bytes())
+}
+
+func poolSalt(token0 common.Address, token1 common.Address, fee *big.Int) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(token0.Bytes(), 32), common.LeftPadBytes(token1.Bytes(), 32),
+		common.LeftPadBytes(fee.Bytes(), 32))
+}
+
+func (a *uniswapV3Adapter) PriceEvents() ethereum.FilterQuery {
+	return ethereum.FilterQuery{Addresses: []common.Address{a.address}, Topics: [][]common.Hash{{a.swapTopic}}}
+}
+
+func (a *uniswapV3Adapter) SwapEvents() ethereum.FilterQuery {
+	return a.PriceEvents()
+}
+
+func (a *uniswapV3Adapter) Decode(l types.Log) (*poolEvent, error) {
+	if a.variant == pancakeswapV3Variant {
+		swap, err := a.pancakeInstance.ParseSwap(l)
+		if err != nil {
+			return nil, err
+		}
+		return concentratedLiquiditySwap(l, swap.Sender, swap.Recipient, swap.Amount0, swap.Amount1,
+			swap.SqrtPriceX96, swap.Liquidity, swap.Tick), nil
+	}
+	swap, err := a.pairInstance.ParseSwap(l)
+	if err != nil {
+		return nil, err
+	}
+	return concentratedLiquiditySwap(l, swap.Sender, swap.Recipient, swap.Amount0, swap.Amount1,
+		swap.SqrtPriceX96, swap.Liquidity, swap.Tick), nil
+}
+
+func concentratedLiquiditySwap(l types.Log, sender common.Address, recipient common.Address, amount0 *big.Int, amount1 *big.Int,
+	sqrtPriceX96 *big.Int, liquidity *big.Int, tick *big.Int) *poolEvent {
+	return &poolEvent{
+		log:   l,
+		state: &poolState{sqrtPriceX96: sqrtPriceX96, tick: tick, liquidity: liquidity},
+		swap:  &swapEvent{sender: sender, recipient: recipient, amounts: []*big.Int{amount0, amount1}},
+	}
+}
+
+func (a *uniswapV3Adapter) Price(state *poolState, base int, quote int) *big.Rat {
+	price := sqrtPriceRatio(state.sqrtPriceX96)
+	if base == 1 && price.Sign() != 0 {
+		price.Inv(price)
+	}
+	return price
+}
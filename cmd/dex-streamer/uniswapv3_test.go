@@ -0,0 +1,162 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	multicall3 "github.com/toamto94/dex-streamer.git/pkg/abigen/multicall3"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"testing"
+)
+
+func TestPoolAddress(t *testing.T) {
+	var (
+		usdc = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+		weth = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+		usdt = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+		wbnb = common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	)
+	factory, _ := chains["ethereum"].factory("uniswapv3")
+	tests := []struct {
+		name        string
+		pancakeswap bool
+		token0      common.Address
+		token1      common.Address
+		fee         int64
+		want        common.Address
+	}{
+		{"Uniswap V3 USDC/WETH 0.05%", false, usdc, weth, 500, common.HexToAddress("0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640")},
+		{"Uniswap V3 USDC/WETH 0.3%", false, usdc, weth, 3000, common.HexToAddress("0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8")},
+		{"PancakeSwap V3 USDT/WBNB 0.05%", true, usdt, wbnb, 500, common.HexToAddress("0x36696169C63e42cd08ce11f5deeBbCeBae652050")},
+	}
+	for _, test := range tests {
+		got := uniswapV3PoolAddress(factory, test.token0, test.token1, big.NewInt(test.fee))
+		if test.pancakeswap {
+			got = pancakeswapV3PoolAddress(test.token0, test.token1, big.NewInt(test.fee))
+		}
+		if got != test.want {
+			t.Errorf("%v: pool address = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// deployedAdapter is an adapter that only reports a deployment.
+type deployedAdapter struct {
+	DEXAdapter
+	deployment *deployment
+}
+
+func (a deployedAdapter) Deployment(opts *bind.CallOpts) (*deployment, error) {
+	return a.deployment, nil
+}
+
+func TestVerify(t *testing.T) {
+	address := common.HexToAddress("0x36696169C63e42cd08ce11f5deeBbCeBae652050")
+	factory, _ := chains["bsc"].factory("pancakeswapv3")
+	tests := []struct {
+		name            string
+		dex             string
+		deployment      *deployment
+		allowUnverified bool
+		refused         bool
+		verified        bool
+	}{
+		{"canonical", "pancakeswapv3", &deployment{dex: "pancakeswapv3", factory: factory, address: address}, false, false, true},
+		{"other dex", "uniswapv3", &deployment{dex: "pancakeswapv3", factory: factory, address: address}, false, true, false},
+		{"other dex allowed", "uniswapv3", &deployment{dex: "pancakeswapv3", factory: factory, address: address}, true, false, false},
+		{"alias", "quickswapv3", &deployment{dex: "algebra"}, false, false, false},
+		{"no known factory", "algebra", &deployment{dex: "algebra"}, false, false, false},
+		{"other factory", "pancakeswapv3", &deployment{dex: "pancakeswapv3", factory: common.HexToAddress("0x1"), address: address}, false, true, false},
+		{"other address", "pancakeswapv3", &deployment{dex: "pancakeswapv3", factory: factory, address: common.HexToAddress("0x1")}, false, true, false},
+		{"unknown kind", "uniswapv3", nil, false, false, false},
+	}
+	for _, test := range tests {
+		p := &pool{
+			contractReader: contractReader{chain: chains["bsc"], address: address},
+			dex:            test.dex,
+			adapter:        deployedAdapter{deployment: test.deployment},
+		}
+		err := p.verify(&bind.CallOpts{}, &proto.Contract{AllowUnverified: test.allowUnverified})
+		if refused := status.Code(err) == codes.FailedPrecondition; refused != test.refused || err != nil && !refused {
+			t.Errorf("%v: verify = %v, refused %v", test.name, err, test.refused)
+		}
+		if p.verified != test.verified {
+			t.Errorf("%v: verified = %v, want %v", test.name, p.verified, test.verified)
+		}
+	}
+}
+
+// aggregate3Output encodes what aggregate3 returns for results, nil entries
+// being reverted calls.
+func aggregate3Output(t *testing.T, results ...[]byte) []byte {
+	t.Helper()
+	var encoded []multicall3.Multicall3Result
+	for _, result := range results {
+		encoded = append(encoded, multicall3.Multicall3Result{Success: result != nil, ReturnData: result})
+	}
+	contract, err := multicall3.Multicall3AbigenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := contract.Methods["aggregate3"].Outputs.Pack(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return output
+}
+
+func TestQueueUniswapV3Adapter(t *testing.T) {
+	var (
+		usdt    = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+		wbnb    = common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+		factory = common.HexToAddress("0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865")
+		word    = func(address common.Address) []byte { return common.LeftPadBytes(address.Bytes(), 32) }
+		fee     = common.LeftPadBytes([]byte{0x01, 0xf4}, 32)
+		address = common.HexToAddress("0x36696169C63e42cd08ce11f5deeBbCeBae652050")
+	)
+	tests := []struct {
+		name    string
+		results [][]byte // lmPool, globalState, token0, token1, factory, fee
+		variant string
+		tokens  bool
+		fee     bool
+	}{
+		{"uniswap", [][]byte{nil, nil, word(usdt), word(wbnb), word(factory), fee}, uniswapV3Variant, true, true},
+		{"pancakeswap", [][]byte{word(common.Address{}), nil, word(usdt), word(wbnb), word(factory), fee}, pancakeswapV3Variant, true, true},
+		{"algebra", [][]byte{nil, make([]byte, 7*32), word(usdt), word(wbnb), word(factory), nil}, algebraVariant, true, false},
+		{"no tokens", [][]byte{nil, nil, nil, word(wbnb), word(factory), fee}, uniswapV3Variant, false, true},
+	}
+	for _, test := range tests {
+		batch := &multicall{}
+		assemble := queueUniswapV3Adapter(batch, address, nil)
+		if len(batch.calls) != len(test.results) {
+			t.Fatalf("%v: %v calls queued, want %v", test.name, len(batch.calls), len(test.results))
+		}
+		if err := batch.decode(aggregate3Output(t, test.results...)); err != nil {
+			t.Fatalf("%v: %v", test.name, err)
+		}
+		adapter, err := assemble()
+		if err != nil {
+			t.Fatalf("%v: %v", test.name, err)
+		}
+		a := adapter.(*uniswapV3Adapter)
+		if a.variant != test.variant {
+			t.Errorf("%v: variant %v, want %v", test.name, a.variant, test.variant)
+		}
+		if tokens := a.tokens != nil; tokens != test.tokens || tokens && (a.tokens[0] != usdt || a.tokens[1] != wbnb) {
+			t.Errorf("%v: tokens %v", test.name, a.tokens)
+		}
+		if (a.fee != nil) != test.fee || a.fee != nil && (a.fee.Int64() != 500 || *a.factory != factory) {
+			t.Errorf("%v: factory %v and fee %v", test.name, a.factory, a.fee)
+		}
+		if test.tokens && test.fee {
+			// Deployment reuses the reads and would fail on the adapter's nil backend otherwise.
+			d, err := a.Deployment(&bind.CallOpts{})
+			if err != nil || d.factory != factory || (d.address == address) != (a.variant == pancakeswapV3Variant) {
+				t.Errorf("%v: deployment %v, %v", test.name, d, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/toamto94/dex-streamer.git/pkg/proto"
+	dexstreamerv2 "github.com/toamto94/dex-streamer.git/pkg/proto/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math/big"
+)
+
+// DEXStreamerV2ServerImp serves the dexstreamer.v2 service with the pools and
+// providers of the v1 server.
+type DEXStreamerV2ServerImp struct {
+	dexstreamerv2.UnimplementedDEXStreamerServer
+	v1 *DEXStreamerServerImp
+}
+
+// contractOf expresses a subscription in the v1 settings pools are dialed and followed with.
+func contractOf(subscription *dexstreamerv2.Subscription) *proto.Contract {
+	contract := &proto.Contract{
+		Endpoint:        subscription.Endpoint,
+		Chain:           subscription.Chain,
+		Address:         subscription.Address,
+		Dex:             subscription.Dex,
+		FromBlock:       subscription.FromBlock,
+		ToBlock:         subscription.ToBlock,
+		Confirmations:   subscription.Confirmations,
+		BlockTag:        subscription.BlockTag,
+		Precision:       subscription.Precision,
+		BaseToken:       subscription.BaseToken,
+		QuoteToken:      subscription.QuoteToken,
+		AllowUnverified: subscription.AllowUnverified,
+	}
+	if cursor := subscription.Cursor; cursor != nil {
+		contract.Cursor = &proto.Cursor{Blocknumber: cursor.Blocknumber, LogIndex: cursor.LogIndex}
+	}
+	return contract
+}
+
+func (server *DEXStreamerV2ServerImp) Stream(subscription *dexstreamerv2.Subscription, stream dexstreamerv2.DEXStreamer_StreamServer) error {
+	ctx := stream.Context()
+	contract := contractOf(subscription)
+	p, err := server.v1.dialPool(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	from, replay := startPosition(contract)
+	if !replay {
+		head, err := p.head(ctx, contract)
+		if err != nil {
+			return streamStatus(err)
+		}
+		from = logPosition{block: head.number + 1}
+	}
+
+	priceEvents := p.adapter.PriceEvents()
+	query := priceEvents
+	if !subscription.OmitSwaps {
+		query = mergeQueries(priceEvents, p.adapter.SwapEvents())
+	}
+
+	return streamStatus(p.followEvents(ctx, contract, query, from, func(l types.Log) error {
+		event, err := p.decode(l)
+		if err != nil {
+			return err
+		}
+		blockTime, err := p.blockTime(ctx, l.BlockHash)
+		if err != nil {
+			return err
+		}
+		send := func(payload func(*dexstreamerv2.Event)) error {
+			message := p.eventEnvelope()
+			message.Blocknumber = l.BlockNumber
+			message.BlockHash = l.BlockHash.Hex()
+			message.BlockTime = timestamppb.New(blockTime)
+			message.Cursor = &dexstreamerv2.Cursor{Blocknumber: l.BlockNumber, LogIndex: uint32(l.Index)}
+			message.TxHash = l.TxHash.Hex()
+			payload(message)
+			return stream.Send(message)
+		}
+
+		if event.swap != nil && !subscription.OmitSwaps {
+			swap := &dexstreamerv2.Swap{Sender: event.swap.sender.Hex(), Recipient: event.swap.recipient.Hex()}
+			for i, amount := range event.swap.amounts {
+				swap.Amounts = append(swap.Amounts, scaleAmount(amount, p.tokens[i].decimals))
+			}
+			if err := send(func(message *dexstreamerv2.Event) { message.Event = &dexstreamerv2.Event_Swap{Swap: swap} }); err != nil {
+				return err
+			}
+		}
+
+		// Uniswap V2 swaps are priced by the Sync event logged next to them.
+		if len(l.Topics) == 0 || !containsHash(priceEvents.Topics[0], l.Topics[0]) {
+			return nil
+		}
+		liquidityChange := event.swap == nil && event.state == nil
+		if subscription.OmitPrices && (!liquidityChange || subscription.OmitLiquidity) {
+			return nil
+		}
+		state := event.state
+		if state == nil {
+			if state, err = p.state(ctx, new(big.Int).SetUint64(l.BlockNumber)); err != nil {
+				return err
+			}
+		}
+		if liquidityChange && !subscription.OmitLiquidity {
+			liquidity := &dexstreamerv2.Liquidity{}
+			for i, reserve := range state.reserves {
+				liquidity.Reserves = append(liquidity.Reserves, scaleAmount(reserve, p.tokens[i].decimals))
+			}
+			if err := send(func(message *dexstreamerv2.Event) {
+				message.Event = &dexstreamerv2.Event_Liquidity{Liquidity: liquidity}
+			}); err != nil {
+				return err
+			}
+		}
+		if subscription.OmitPrices {
+			return nil
+		}
+		price := p.priceMessage(state)
+		return send(func(message *dexstreamerv2.Event) { message.Event = &dexstreamerv2.Event_Price{Price: price} })
+	}, func(retraction *proto.Retraction) error {
+		message := p.eventEnvelope()
+		message.Blocknumber = retraction.Blocknumber
+		message.Event = &dexstreamerv2.Event_Reorg{Reorg: &dexstreamerv2.Reorg{
+			Blocknumber: retraction.Blocknumber, OrphanedBlockHashes: retraction.OrphanedBlockHashes}}
+		return stream.Send(message)
+	}))
+}
+
+// eventEnvelope fills in what every event of the pool carries.
+func (p *pool) eventEnvelope() *dexstreamerv2.Event {
+	message := &dexstreamerv2.Event{ChainId: p.chain.id, Pool: p.address.Hex(), ObservedAt: timestamppb.Now(), Verified: p.verified}
+	for _, t := range p.tokens {
+		message.Tokens = append(message.Tokens, t.label())
+	}
+	return message
+}
+
+// priceMessage is the v2 form of priceResponse.
+func (p *pool) priceMessage(state *poolState) *dexstreamerv2.Price {
+	response := p.priceResponse(state)
+	price := &dexstreamerv2.Price{
+		BaseToken:         response.BaseToken,
+		QuoteToken:        response.QuoteToken,
+		ExactPrice:        response.ExactPrice,
+		Price:             response.Price,
+		ExactInversePrice: response.ExactInversePrice,
+		InversePrice:      response.InversePrice,
+		SqrtPriceX96:      response.SqrtPriceX96,
+		Liquidity:         response.Liquidity,
+		Reserves:          response.Reserves,
+		EffectivePrice:    response.EffectivePrice,
+	}
+	if state.tick != nil {
+		price.Tick = state.tick.Int64()
+	}
+	return price
+}
@@ -15,7 +15,7 @@ import (
 var (
 	endpoint = flag.String("e", "localhost", "API endpoint to connect with")
 	port     = flag.Int64("p", 50051, "Endpoint port")
-	infuraId = flag.String("i", "", "Infura Project ID")
+	provider = flag.String("n", "", "Name of a provider configured on the server")
 )
 
 func main() {
@@ -32,7 +32,7 @@ func main() {
 	client := proto.NewDEXStreamerClient(conn)
 
 	contract := proto.Contract{
-		Endpoint:       "https://mainnet.infura.io/v3/" + *infuraId,
+		Endpoint:       *provider,
 		Chain:          "ethereum",
 		Dex:            "uniswapV3",
 		Address:        "0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640",
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -25,11 +26,42 @@ type Contract struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint       string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Chain          string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
-	Address        string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Dex            string `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
+	// Name of a provider the server configured for chain. Unset lets the server pick.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// One of ethereum, polygon, arbitrum, optimism, base or bsc.
+	Chain   string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Protocol of the pool: uniswapV3 (default), pancakeswapV3, algebra, quickswapV3,
+	// uniswapV2, sushiswap, pancakeswapV2, curve or balancer. Concentrated liquidity
+	// pools must be requested as the fork they are: a PancakeSwap V3 or Algebra
+	// pool requested as uniswapV3 is refused unless allowUnverified is set.
+	// Balancer pools are addressed by pool, not by Vault.
+	Dex string `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
+	// Milliseconds between polls, the chain's block time if unset.
 	ScrapeInterval uint32 `protobuf:"varint,5,opt,name=scrapeInterval,proto3" json:"scrapeInterval,omitempty"`
+	// Replay Swap events from this block on before going live. Zero streams live only.
+	FromBlock uint64 `protobuf:"varint,6,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	// Stop after this block. Zero keeps following the chain.
+	ToBlock uint64 `protobuf:"varint,7,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	// Resume after the last update a previous stream delivered.
+	Cursor *Cursor `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Read state this many blocks below the tagged block. Without a block tag,
+	// streams polling HTTP providers read at the chain's default depth, while
+	// websocket providers push events as they are mined and retractions follow reorgs.
+	Confirmations uint32 `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// One of latest (default), safe or finalized.
+	BlockTag string `protobuf:"bytes,10,opt,name=blockTag,proto3" json:"blockTag,omitempty"`
+	// Decimal places of Response.exactPrice, 18 if unset and at most 78.
+	Precision uint32 `protobuf:"varint,11,opt,name=precision,proto3" json:"precision,omitempty"`
+	// Address or symbol of the token prices are quoted in, token1 if unset.
+	QuoteToken string `protobuf:"bytes,12,opt,name=quoteToken,proto3" json:"quoteToken,omitempty"`
+	// Address or symbol of the token prices are given for. Unset picks token0,
+	// or token1 if token0 is the quote token.
+	BaseToken string `protobuf:"bytes,13,opt,name=baseToken,proto3" json:"baseToken,omitempty"`
+	// Stream concentrated liquidity pools that are not the requested dex or fail
+	// the factory provenance check instead of refusing them. Their updates are
+	// not marked verified.
+	AllowUnverified bool `protobuf:"varint,14,opt,name=allowUnverified,proto3" json:"allowUnverified,omitempty"`
 }
 
 func (x *Contract) Reset() {
@@ -99,35 +131,854 @@ func (x *Contract) GetScrapeInterval() uint32 {
 	return 0
 }
 
+func (x *Contract) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *Contract) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+func (x *Contract) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *Contract) GetConfirmations() uint32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Contract) GetBlockTag() string {
+	if x != nil {
+		return x.BlockTag
+	}
+	return ""
+}
+
+func (x *Contract) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Contract) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *Contract) GetBaseToken() string {
+	if x != nil {
+		return x.BaseToken
+	}
+	return ""
+}
+
+func (x *Contract) GetAllowUnverified() bool {
+	if x != nil {
+		return x.AllowUnverified
+	}
+	return false
+}
+
+// Cursor is a position in the chain's log order. A logIndex of 4294967295
+// stands for the end of the block, as used by updates read from pool state.
+type Cursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocknumber uint64 `protobuf:"varint,1,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
+	LogIndex    uint32 `protobuf:"varint,2,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+}
+
+func (x *Cursor) Reset() {
+	*x = Cursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cursor) ProtoMessage() {}
+
+func (x *Cursor) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cursor.ProtoReflect.Descriptor instead.
+func (*Cursor) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Cursor) GetBlocknumber() uint64 {
+	if x != nil {
+		return x.Blocknumber
+	}
+	return 0
+}
+
+func (x *Cursor) GetLogIndex() uint32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
 type Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimeStamp   string  `protobuf:"bytes,1,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
-	Token0      string  `protobuf:"bytes,2,opt,name=token0,proto3" json:"token0,omitempty"`
-	Token1      string  `protobuf:"bytes,3,opt,name=token1,proto3" json:"token1,omitempty"`
-	Blocknumber int32   `protobuf:"varint,4,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
-	SpotPrice   float32 `protobuf:"fixed32,5,opt,name=spotPrice,proto3" json:"spotPrice,omitempty"`
+	// Server wall clock as text, kept for older clients. Use blockTime or observedAt.
+	TimeStamp   string  `protobuf:"bytes,1,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	Token0      string  `protobuf:"bytes,2,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1      string  `protobuf:"bytes,3,opt,name=token1,proto3" json:"token1,omitempty"`
+	Blocknumber int32   `protobuf:"varint,4,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
+	SpotPrice   float32 `protobuf:"fixed32,5,opt,name=spotPrice,proto3" json:"spotPrice,omitempty"`
+	Tick        int32   `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Only set for updates decoded from Swap events.
+	Liquidity string  `protobuf:"bytes,7,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	Cursor    *Cursor `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	BlockHash string  `protobuf:"bytes,9,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	// Set on messages that withdraw earlier updates instead of carrying a price.
+	Retraction   *Retraction `protobuf:"bytes,10,opt,name=retraction,proto3" json:"retraction,omitempty"`
+	SqrtPriceX96 string      `protobuf:"bytes,11,opt,name=sqrtPriceX96,proto3" json:"sqrtPriceX96,omitempty"`
+	// Quote token per base token, exact up to Contract.precision decimal places.
+	ExactPrice string  `protobuf:"bytes,12,opt,name=exactPrice,proto3" json:"exactPrice,omitempty"`
+	Price      float64 `protobuf:"fixed64,13,opt,name=price,proto3" json:"price,omitempty"`
+	// Base token per quote token.
+	ExactInversePrice string  `protobuf:"bytes,14,opt,name=exactInversePrice,proto3" json:"exactInversePrice,omitempty"`
+	InversePrice      float64 `protobuf:"fixed64,15,opt,name=inversePrice,proto3" json:"inversePrice,omitempty"`
+	BaseToken         string  `protobuf:"bytes,16,opt,name=baseToken,proto3" json:"baseToken,omitempty"`
+	QuoteToken        string  `protobuf:"bytes,17,opt,name=quoteToken,proto3" json:"quoteToken,omitempty"`
+	// Token balances of pools priced from their reserves, in whole tokens and
+	// pool token order.
+	Reserves []string `protobuf:"bytes,18,rep,name=reserves,proto3" json:"reserves,omitempty"`
+	// Quote tokens one base token fetches, fees included, for pools that can
+	// quote trades such as Curve.
+	EffectivePrice string `protobuf:"bytes,19,opt,name=effectivePrice,proto3" json:"effectivePrice,omitempty"`
+	// Set when the pool was checked to be deployed by the chain's canonical factory.
+	Verified bool `protobuf:"varint,20,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Timestamp of the block the price was read at or the event was logged in.
+	// Unset on retractions.
+	BlockTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	// When the server read the update.
+	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=observedAt,proto3" json:"observedAt,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Response) GetTimeStamp() string {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return ""
+}
+
+func (x *Response) GetToken0() string {
+	if x != nil {
+		return x.Token0
+	}
+	return ""
+}
+
+func (x *Response) GetToken1() string {
+	if x != nil {
+		return x.Token1
+	}
+	return ""
+}
+
+func (x *Response) GetBlocknumber() int32 {
+	if x != nil {
+		return x.Blocknumber
+	}
+	return 0
+}
+
+func (x *Response) GetSpotPrice() float32 {
+	if x != nil {
+		return x.SpotPrice
+	}
+	return 0
+}
+
+func (x *Response) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *Response) GetLiquidity() string {
+	if x != nil {
+		return x.Liquidity
+	}
+	return ""
+}
+
+func (x *Response) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *Response) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *Response) GetRetraction() *Retraction {
+	if x != nil {
+		return x.Retraction
+	}
+	return nil
+}
+
+func (x *Response) GetSqrtPriceX96() string {
+	if x != nil {
+		return x.SqrtPriceX96
+	}
+	return ""
+}
+
+func (x *Response) GetExactPrice() string {
+	if x != nil {
+		return x.ExactPrice
+	}
+	return ""
+}
+
+func (x *Response) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Response) GetExactInversePrice() string {
+	if x != nil {
+		return x.ExactInversePrice
+	}
+	return ""
+}
+
+func (x *Response) GetInversePrice() float64 {
+	if x != nil {
+		return x.InversePrice
+	}
+	return 0
+}
+
+func (x *Response) GetBaseToken() string {
+	if x != nil {
+		return x.BaseToken
+	}
+	return ""
+}
+
+func (x *Response) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *Response) GetReserves() []string {
+	if x != nil {
+		return x.Reserves
+	}
+	return nil
+}
+
+func (x *Response) GetEffectivePrice() string {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return ""
+}
+
+func (x *Response) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Response) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *Response) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+// Retraction withdraws every update a stream emitted from blocknumber on,
+// because the listed blocks are no longer part of the canonical chain. Updates
+// from the replacing blocks follow as regular messages.
+type Retraction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocknumber         uint64   `protobuf:"varint,1,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
+	OrphanedBlockHashes []string `protobuf:"bytes,2,rep,name=orphanedBlockHashes,proto3" json:"orphanedBlockHashes,omitempty"`
+}
+
+func (x *Retraction) Reset() {
+	*x = Retraction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retraction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retraction) ProtoMessage() {}
+
+func (x *Retraction) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retraction.ProtoReflect.Descriptor instead.
+func (*Retraction) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Retraction) GetBlocknumber() uint64 {
+	if x != nil {
+		return x.Blocknumber
+	}
+	return 0
+}
+
+func (x *Retraction) GetOrphanedBlockHashes() []string {
+	if x != nil {
+		return x.OrphanedBlockHashes
+	}
+	return nil
+}
+
+// Swap is a decoded Uniswap V3 Swap event. Amounts are signed from the pool's
+// point of view and scaled by the token decimals.
+type Swap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Server wall clock as text, kept for older clients. Use blockTime or observedAt.
+	TimeStamp    string `protobuf:"bytes,1,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	Token0       string `protobuf:"bytes,2,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1       string `protobuf:"bytes,3,opt,name=token1,proto3" json:"token1,omitempty"`
+	Sender       string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient    string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount0      string `protobuf:"bytes,6,opt,name=amount0,proto3" json:"amount0,omitempty"`
+	Amount1      string `protobuf:"bytes,7,opt,name=amount1,proto3" json:"amount1,omitempty"`
+	SqrtPriceX96 string `protobuf:"bytes,8,opt,name=sqrtPriceX96,proto3" json:"sqrtPriceX96,omitempty"`
+	Liquidity    string `protobuf:"bytes,9,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	Tick         int32  `protobuf:"varint,10,opt,name=tick,proto3" json:"tick,omitempty"`
+	TxHash       string `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	LogIndex     uint32 `protobuf:"varint,12,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	Blocknumber  uint64 `protobuf:"varint,13,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
+	BlockHash    string `protobuf:"bytes,14,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	// Set on messages that withdraw earlier swaps instead of carrying one.
+	Retraction *Retraction `protobuf:"bytes,15,opt,name=retraction,proto3" json:"retraction,omitempty"`
+	// Change of every pool balance in pool token order, positive when the pool
+	// received the token. amount0 and amount1 repeat the first two.
+	Amounts []string `protobuf:"bytes,16,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	// Set when the pool was checked to be deployed by the chain's canonical factory.
+	Verified bool `protobuf:"varint,17,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Timestamp of the block the swap was logged in. Unset on retractions.
+	BlockTime *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	// When the server read the swap.
+	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=observedAt,proto3" json:"observedAt,omitempty"`
+}
+
+func (x *Swap) Reset() {
+	*x = Swap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Swap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Swap) ProtoMessage() {}
+
+func (x *Swap) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Swap.ProtoReflect.Descriptor instead.
+func (*Swap) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Swap) GetTimeStamp() string {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return ""
+}
+
+func (x *Swap) GetToken0() string {
+	if x != nil {
+		return x.Token0
+	}
+	return ""
+}
+
+func (x *Swap) GetToken1() string {
+	if x != nil {
+		return x.Token1
+	}
+	return ""
+}
+
+func (x *Swap) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Swap) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Swap) GetAmount0() string {
+	if x != nil {
+		return x.Amount0
+	}
+	return ""
+}
+
+func (x *Swap) GetAmount1() string {
+	if x != nil {
+		return x.Amount1
+	}
+	return ""
+}
+
+func (x *Swap) GetSqrtPriceX96() string {
+	if x != nil {
+		return x.SqrtPriceX96
+	}
+	return ""
+}
+
+func (x *Swap) GetLiquidity() string {
+	if x != nil {
+		return x.Liquidity
+	}
+	return ""
+}
+
+func (x *Swap) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *Swap) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Swap) GetLogIndex() uint32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *Swap) GetBlocknumber() uint64 {
+	if x != nil {
+		return x.Blocknumber
+	}
+	return 0
+}
+
+func (x *Swap) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *Swap) GetRetraction() *Retraction {
+	if x != nil {
+		return x.Retraction
+	}
+	return nil
+}
+
+func (x *Swap) GetAmounts() []string {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *Swap) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Swap) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *Swap) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+// PoolQuery selects the pools a Uniswap V3 style factory deployed.
+type PoolQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a provider the server configured for chain. Unset lets the server pick.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// One of ethereum, polygon, arbitrum, optimism, base or bsc.
+	Chain string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// Address or well known symbol, such as WETH or USDC, of the pooled tokens.
+	// FindPools needs both, StreamNewPools streams pools of any token left unset.
+	TokenA string `protobuf:"bytes,3,opt,name=tokenA,proto3" json:"tokenA,omitempty"`
+	TokenB string `protobuf:"bytes,4,opt,name=tokenB,proto3" json:"tokenB,omitempty"`
+	// Address of the factory, the chain's Uniswap V3 factory if unset.
+	Factory string `protobuf:"bytes,5,opt,name=factory,proto3" json:"factory,omitempty"`
+	// StreamNewPools replays pools created from this block on before going live.
+	FromBlock uint64 `protobuf:"varint,6,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+}
+
+func (x *PoolQuery) Reset() {
+	*x = PoolQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolQuery) ProtoMessage() {}
+
+func (x *PoolQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolQuery.ProtoReflect.Descriptor instead.
+func (*PoolQuery) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PoolQuery) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *PoolQuery) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PoolQuery) GetTokenA() string {
+	if x != nil {
+		return x.TokenA
+	}
+	return ""
+}
+
+func (x *PoolQuery) GetTokenB() string {
+	if x != nil {
+		return x.TokenB
+	}
+	return ""
+}
+
+func (x *PoolQuery) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *PoolQuery) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+// Pool is a pool deployed by a factory. Pools found by FindPools carry their
+// current liquidity, pools streamed by StreamNewPools the creating log.
+type Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Token0  string `protobuf:"bytes,2,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1  string `protobuf:"bytes,3,opt,name=token1,proto3" json:"token1,omitempty"`
+	// Fee in hundredths of a basis point.
+	Fee         uint32 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	TickSpacing int32  `protobuf:"varint,5,opt,name=tickSpacing,proto3" json:"tickSpacing,omitempty"`
+	Liquidity   string `protobuf:"bytes,6,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	Blocknumber uint64 `protobuf:"varint,7,opt,name=blocknumber,proto3" json:"blocknumber,omitempty"`
+	TxHash      string `protobuf:"bytes,8,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Factory     string `protobuf:"bytes,9,opt,name=factory,proto3" json:"factory,omitempty"`
+	// Set on messages that withdraw earlier pools instead of carrying one.
+	Retraction *Retraction `protobuf:"bytes,10,opt,name=retraction,proto3" json:"retraction,omitempty"`
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Pool) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pool) GetToken0() string {
+	if x != nil {
+		return x.Token0
+	}
+	return ""
+}
+
+func (x *Pool) GetToken1() string {
+	if x != nil {
+		return x.Token1
+	}
+	return ""
+}
+
+func (x *Pool) GetFee() uint32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Pool) GetTickSpacing() int32 {
+	if x != nil {
+		return x.TickSpacing
+	}
+	return 0
+}
+
+func (x *Pool) GetLiquidity() string {
+	if x != nil {
+		return x.Liquidity
+	}
+	return ""
+}
+
+func (x *Pool) GetBlocknumber() uint64 {
+	if x != nil {
+		return x.Blocknumber
+	}
+	return 0
+}
+
+func (x *Pool) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Pool) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *Pool) GetRetraction() *Retraction {
+	if x != nil {
+		return x.Retraction
+	}
+	return nil
+}
+
+type Pools struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools []*Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *Pools) Reset() {
+	*x = Pools{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_definition_proto_msgTypes[1]
+		mi := &file_service_definition_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Response) String() string {
+func (x *Pools) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*Pools) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_service_definition_proto_msgTypes[1]
+func (x *Pools) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,75 +989,366 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_service_definition_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Pools.ProtoReflect.Descriptor instead.
+func (*Pools) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Response) GetTimeStamp() string {
+func (x *Pools) GetPools() []*Pool {
 	if x != nil {
-		return x.TimeStamp
+		return x.Pools
+	}
+	return nil
+}
+
+type TokenQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a provider the server configured for chain. Unset lets the server pick.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// One of ethereum, polygon, arbitrum, optimism, base or bsc.
+	Chain string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// Address or well known symbol of the token.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *TokenQuery) Reset() {
+	*x = TokenQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenQuery) ProtoMessage() {}
+
+func (x *TokenQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenQuery.ProtoReflect.Descriptor instead.
+func (*TokenQuery) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TokenQuery) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Response) GetToken0() string {
+func (x *TokenQuery) GetChain() string {
 	if x != nil {
-		return x.Token0
+		return x.Chain
 	}
 	return ""
 }
 
-func (x *Response) GetToken1() string {
+func (x *TokenQuery) GetToken() string {
 	if x != nil {
-		return x.Token1
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Response) GetBlocknumber() int32 {
+// Token is the metadata of an ERC-20 token. Name and symbol are empty for
+// tokens that do not have them.
+type Token struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain    string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Interfaces the token answers to: erc20, eip2612 (permit), ownable and pausable.
+	Interfaces []string `protobuf:"bytes,6,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_service_definition_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Token) GetChain() string {
 	if x != nil {
-		return x.Blocknumber
+		return x.Chain
 	}
-	return 0
+	return ""
 }
 
-func (x *Response) GetSpotPrice() float32 {
+func (x *Token) GetAddress() string {
 	if x != nil {
-		return x.SpotPrice
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Token) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
 	}
 	return 0
 }
 
+func (x *Token) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
 var File_service_definition_proto protoreflect.FileDescriptor
 
 var file_service_definition_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x08, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73,
-	0x63, 0x72, 0x61, 0x70, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x98, 0x01,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70,
-	0x6f, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x73,
-	0x70, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x39, 0x0a, 0x0b, 0x44, 0x45, 0x58, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x09, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x42, 0x08, 0x5a, 0x06, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x03, 0x0a, 0x08,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22,
+	0x46, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c,
+	0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xf6, 0x05, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x73, 0x70, 0x6f, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x58, 0x39, 0x36,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x71, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x58, 0x39, 0x36, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x61, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x63, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x60, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x13, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x22, 0xe1, 0x04, 0x0a, 0x04, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x30, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x30, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x30, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x71, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x58, 0x39, 0x36, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x71, 0x72, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x58, 0x39, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2b, 0x0a,
+	0x0a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x38, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x6f, 0x6c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xa3,
+	0x02, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x31, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x53, 0x70,
+	0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x05, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1b, 0x0a,
+	0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x54, 0x0a, 0x0a, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x9f, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x32, 0xcd, 0x01, 0x0a, 0x0b, 0x44, 0x45, 0x58, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x09, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a,
+	0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x23,
+	0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x09, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x05, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x12, 0x0a, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x06, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4e, 0x65, 0x77, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x0a, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x1a, 0x05, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x21, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0b, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x06, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x00, 0x42, 0x08, 0x5a, 0x06, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -221,19 +1363,46 @@ func file_service_definition_proto_rawDescGZIP() []byte {
 	return file_service_definition_proto_rawDescData
 }
 
-var file_service_definition_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_service_definition_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_service_definition_proto_goTypes = []interface{}{
-	(*Contract)(nil), // 0: Contract
-	(*Response)(nil), // 1: Response
+	(*Contract)(nil),              // 0: Contract
+	(*Cursor)(nil),                // 1: Cursor
+	(*Response)(nil),              // 2: Response
+	(*Retraction)(nil),            // 3: Retraction
+	(*Swap)(nil),                  // 4: Swap
+	(*PoolQuery)(nil),             // 5: PoolQuery
+	(*Pool)(nil),                  // 6: Pool
+	(*Pools)(nil),                 // 7: Pools
+	(*TokenQuery)(nil),            // 8: TokenQuery
+	(*Token)(nil),                 // 9: Token
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_service_definition_proto_depIdxs = []int32{
-	0, // 0: DEXStreamer.StreamContract:input_type -> Contract
-	1, // 1: DEXStreamer.StreamContract:output_type -> Response
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,  // 0: Contract.cursor:type_name -> Cursor
+	1,  // 1: Response.cursor:type_name -> Cursor
+	3,  // 2: Response.retraction:type_name -> Retraction
+	10, // 3: Response.blockTime:type_name -> google.protobuf.Timestamp
+	10, // 4: Response.observedAt:type_name -> google.protobuf.Timestamp
+	3,  // 5: Swap.retraction:type_name -> Retraction
+	10, // 6: Swap.blockTime:type_name -> google.protobuf.Timestamp
+	10, // 7: Swap.observedAt:type_name -> google.protobuf.Timestamp
+	3,  // 8: Pool.retraction:type_name -> Retraction
+	6,  // 9: Pools.pools:type_name -> Pool
+	0,  // 10: DEXStreamer.StreamContract:input_type -> Contract
+	0,  // 11: DEXStreamer.StreamSwaps:input_type -> Contract
+	5,  // 12: DEXStreamer.FindPools:input_type -> PoolQuery
+	5,  // 13: DEXStreamer.StreamNewPools:input_type -> PoolQuery
+	8,  // 14: DEXStreamer.GetToken:input_type -> TokenQuery
+	2,  // 15: DEXStreamer.StreamContract:output_type -> Response
+	4,  // 16: DEXStreamer.StreamSwaps:output_type -> Swap
+	7,  // 17: DEXStreamer.FindPools:output_type -> Pools
+	6,  // 18: DEXStreamer.StreamNewPools:output_type -> Pool
+	9,  // 19: DEXStreamer.GetToken:output_type -> Token
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_service_definition_proto_init() }
@@ -255,6 +1424,18 @@ func file_service_definition_proto_init() {
 			}
 		}
 		file_service_definition_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
@@ -266,6 +1447,90 @@ func file_service_definition_proto_init() {
 				return nil
 			}
 		}
+		file_service_definition_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retraction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Swap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pools); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Token); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -273,7 +1538,7 @@ func file_service_definition_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_definition_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DEXStreamerClient interface {
 	StreamContract(ctx context.Context, in *Contract, opts ...grpc.CallOption) (DEXStreamer_StreamContractClient, error)
+	StreamSwaps(ctx context.Context, in *Contract, opts ...grpc.CallOption) (DEXStreamer_StreamSwapsClient, error)
+	FindPools(ctx context.Context, in *PoolQuery, opts ...grpc.CallOption) (*Pools, error)
+	StreamNewPools(ctx context.Context, in *PoolQuery, opts ...grpc.CallOption) (DEXStreamer_StreamNewPoolsClient, error)
+	GetToken(ctx context.Context, in *TokenQuery, opts ...grpc.CallOption) (*Token, error)
 }
 
 type dEXStreamerClient struct {
@@ -65,11 +69,97 @@ func (x *dEXStreamerStreamContractClient) Recv() (*Response, error) {
 	return m, nil
 }
 
+func (c *dEXStreamerClient) StreamSwaps(ctx context.Context, in *Contract, opts ...grpc.CallOption) (DEXStreamer_StreamSwapsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DEXStreamer_ServiceDesc.Streams[1], "/DEXStreamer/StreamSwaps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dEXStreamerStreamSwapsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DEXStreamer_StreamSwapsClient interface {
+	Recv() (*Swap, error)
+	grpc.ClientStream
+}
+
+type dEXStreamerStreamSwapsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dEXStreamerStreamSwapsClient) Recv() (*Swap, error) {
+	m := new(Swap)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dEXStreamerClient) FindPools(ctx context.Context, in *PoolQuery, opts ...grpc.CallOption) (*Pools, error) {
+	out := new(Pools)
+	err := c.cc.Invoke(ctx, "/DEXStreamer/FindPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dEXStreamerClient) StreamNewPools(ctx context.Context, in *PoolQuery, opts ...grpc.CallOption) (DEXStreamer_StreamNewPoolsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DEXStreamer_ServiceDesc.Streams[2], "/DEXStreamer/StreamNewPools", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dEXStreamerStreamNewPoolsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DEXStreamer_StreamNewPoolsClient interface {
+	Recv() (*Pool, error)
+	grpc.ClientStream
+}
+
+type dEXStreamerStreamNewPoolsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dEXStreamerStreamNewPoolsClient) Recv() (*Pool, error) {
+	m := new(Pool)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dEXStreamerClient) GetToken(ctx context.Context, in *TokenQuery, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := c.cc.Invoke(ctx, "/DEXStreamer/GetToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DEXStreamerServer is the server API for DEXStreamer service.
 // All implementations must embed UnimplementedDEXStreamerServer
 // for forward compatibility
 type DEXStreamerServer interface {
 	StreamContract(*Contract, DEXStreamer_StreamContractServer) error
+	StreamSwaps(*Contract, DEXStreamer_StreamSwapsServer) error
+	FindPools(context.Context, *PoolQuery) (*Pools, error)
+	StreamNewPools(*PoolQuery, DEXStreamer_StreamNewPoolsServer) error
+	GetToken(context.Context, *TokenQuery) (*Token, error)
 	mustEmbedUnimplementedDEXStreamerServer()
 }
 
@@ -80,6 +170,18 @@ type UnimplementedDEXStreamerServer struct {
 func (UnimplementedDEXStreamerServer) StreamContract(*Contract, DEXStreamer_StreamContractServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamContract not implemented")
 }
+func (UnimplementedDEXStreamerServer) StreamSwaps(*Contract, DEXStreamer_StreamSwapsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSwaps not implemented")
+}
+func (UnimplementedDEXStreamerServer) FindPools(context.Context, *PoolQuery) (*Pools, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPools not implemented")
+}
+func (UnimplementedDEXStreamerServer) StreamNewPools(*PoolQuery, DEXStreamer_StreamNewPoolsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNewPools not implemented")
+}
+func (UnimplementedDEXStreamerServer) GetToken(context.Context, *TokenQuery) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
 func (UnimplementedDEXStreamerServer) mustEmbedUnimplementedDEXStreamerServer() {}
 
 // UnsafeDEXStreamerServer may be embedded to opt out of forward compatibility for this service.
@@ -114,19 +216,116 @@ func (x *dEXStreamerStreamContractServer) Send(m *Response) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DEXStreamer_StreamSwaps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Contract)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DEXStreamerServer).StreamSwaps(m, &dEXStreamerStreamSwapsServer{stream})
+}
+
+type DEXStreamer_StreamSwapsServer interface {
+	Send(*Swap) error
+	grpc.ServerStream
+}
+
+type dEXStreamerStreamSwapsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dEXStreamerStreamSwapsServer) Send(m *Swap) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DEXStreamer_FindPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEXStreamerServer).FindPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DEXStreamer/FindPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEXStreamerServer).FindPools(ctx, req.(*PoolQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DEXStreamer_StreamNewPools_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PoolQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DEXStreamerServer).StreamNewPools(m, &dEXStreamerStreamNewPoolsServer{stream})
+}
+
+type DEXStreamer_StreamNewPoolsServer interface {
+	Send(*Pool) error
+	grpc.ServerStream
+}
+
+type dEXStreamerStreamNewPoolsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dEXStreamerStreamNewPoolsServer) Send(m *Pool) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DEXStreamer_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEXStreamerServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DEXStreamer/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEXStreamerServer).GetToken(ctx, req.(*TokenQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DEXStreamer_ServiceDesc is the grpc.ServiceDesc for DEXStreamer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var DEXStreamer_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "DEXStreamer",
 	HandlerType: (*DEXStreamerServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindPools",
+			Handler:    _DEXStreamer_FindPools_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _DEXStreamer_GetToken_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StreamContract",
 			Handler:       _DEXStreamer_StreamContract_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamSwaps",
+			Handler:       _DEXStreamer_StreamSwaps_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNewPools",
+			Handler:       _DEXStreamer_StreamNewPools_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "service-definition.proto",
 }